@@ -0,0 +1,143 @@
+package henge
+
+import (
+	"reflect"
+)
+
+type (
+	// BytesConverter is a converter that converts a byte slice type to another type.
+	BytesConverter struct {
+		*baseConverter
+		value []byte
+		err   error
+	}
+)
+
+// --------------------------------------------------------------------- //
+// ValueConverter
+// --------------------------------------------------------------------- //
+
+// Bytes converts the input to byte slice type.
+//
+// When the input is a string, it decodes using the encoding specified by WithBytesEncoding.
+func (c *ValueConverter) Bytes() *BytesConverter {
+	var (
+		value []byte
+		err   error
+	)
+
+	inV := reflect.Indirect(c.reflectValue)
+	if inV.IsValid() {
+		switch inV.Kind() {
+		case reflect.String:
+			value, err = c.opts.bytesOpts.decode(inV.String())
+		case reflect.Array, reflect.Slice:
+			if isBytesType(inV.Type()) {
+				value = bytesOf(inV)
+			} else {
+				err = c.Slice().Convert(&value)
+			}
+		default:
+			err = ErrUnsupportedType
+		}
+	} else {
+		err = ErrInvalidValue
+	}
+
+	if err != nil {
+		err = c.wrapConvertError(c.value, reflect.TypeOf(value), err)
+	}
+	if c.isNil {
+		return &BytesConverter{baseConverter: c.baseConverter, value: nil, err: err}
+	}
+	return &BytesConverter{baseConverter: c.baseConverter, value: value, err: err}
+}
+
+// --------------------------------------------------------------------- //
+// BytesConverter
+// --------------------------------------------------------------------- //
+
+// Convert converts the input to the out type and assigns it.
+// If the conversion fails, the method returns an error.
+func (c *BytesConverter) Convert(out interface{}) error {
+	outV := reflect.ValueOf(out)
+	if outV.Kind() != reflect.Ptr {
+		panic("out must be ptr")
+	}
+	return c.convert(outV.Elem())
+}
+
+func (c *BytesConverter) convert(outV reflect.Value) error {
+	if c.err != nil {
+		return c.wrapConvertError(c.value, outV.Type(), c.err)
+	}
+	if c.isNil {
+		return nil
+	}
+
+	elemOutV := toInitializedNonPtrValue(outV)
+
+	switch elemOutV.Kind() {
+	case reflect.Slice:
+		if !isBytesType(elemOutV.Type()) {
+			break
+		}
+		v := reflect.MakeSlice(elemOutV.Type(), len(c.value), len(c.value))
+		for i, b := range c.value {
+			v.Index(i).SetUint(uint64(b))
+		}
+		elemOutV.Set(v)
+		return nil
+	case reflect.Array:
+		if !isBytesType(elemOutV.Type()) {
+			break
+		}
+		if err := c.opts.sliceOpts.checkArrayLength(len(c.value), elemOutV.Len()); err != nil {
+			return c.wrapConvertError(c.value, outV.Type(), err)
+		}
+		v := reflect.New(elemOutV.Type()).Elem()
+		for i := 0; i < len(c.value) && i < v.Len(); i++ {
+			v.Index(i).SetUint(uint64(c.value[i]))
+		}
+		elemOutV.Set(v)
+		return nil
+	case reflect.String:
+		elemOutV.SetString(c.opts.bytesOpts.encode(c.value))
+		return nil
+	}
+	return c.new(c.value, c.field).convert(outV)
+}
+
+// Result returns the conversion result and error.
+func (c *BytesConverter) Result() ([]byte, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *BytesConverter) Value() []byte {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *BytesConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *BytesConverter) Error() error {
+	return c.err
+}
+
+// isBytesType returns true if the type is a slice or an array of bytes.
+func isBytesType(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8
+}
+
+// bytesOf returns a copy of the byte slice (or array) held by v.
+func bytesOf(v reflect.Value) []byte {
+	b := make([]byte, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		b[i] = byte(v.Index(i).Uint())
+	}
+	return b
+}
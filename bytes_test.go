@@ -0,0 +1,75 @@
+package henge
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+func ExampleValueConverter_Bytes() {
+	var s string
+	if err := New([]byte("abc")).Convert(&s); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", s)
+	}
+
+	var b []byte
+	if err := New("abc").Convert(&b); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", b)
+	}
+
+	var i []int
+	if err := New([]byte("abc")).Convert(&i); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", i)
+	}
+
+	// Output:
+	// "abc"
+	// []byte{0x61, 0x62, 0x63}
+	// []int{97, 98, 99}
+}
+
+func ExampleWithBase64Bytes() {
+	type In struct {
+		Data []byte
+	}
+	type Out struct {
+		Data string
+	}
+
+	var out Out
+	if err := New(In{Data: []byte("abc")}, WithBase64Bytes(base64.StdEncoding)).Convert(&out); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", out)
+	}
+
+	var in In
+	if err := New(out, WithBase64Bytes(base64.StdEncoding)).Convert(&in); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", in)
+	}
+
+	fmt.Printf("%#v\n", New(In{Data: []byte("abc")}, WithBase64Bytes(base64.StdEncoding)).JSONValue().Value())
+
+	// Output:
+	// henge.Out{Data:"YWJj"}
+	// henge.In{Data:[]uint8{0x61, 0x62, 0x63}}
+	// map[string]interface {}{"Data":"YWJj"}
+}
+
+func ExampleWithHexBytes() {
+	fmt.Println(New([]byte("abc"), WithHexBytes()).String().Value())
+	fmt.Println(New("616263", WithHexBytes()).Bytes().Value())
+	fmt.Println(New("xyz", WithHexBytes()).Bytes().Error())
+
+	// Output:
+	// 616263
+	// [97 98 99]
+	// Failed to convert from string to []uint8: fields=, value="xyz", error=encoding/hex: invalid byte: U+0078 'x'
+}
@@ -0,0 +1,134 @@
+package henge
+
+import (
+	"reflect"
+)
+
+// Clone returns a deep copy of the input.
+//
+// Pointers, slices, maps and arrays are copied recursively, and the shared references (including cycles) are kept in the copy.
+// Fields with `henge:"-"` tag are not copied, and unexported fields are copied as is.
+func Clone(i interface{}) interface{} {
+	if i == nil {
+		return nil
+	}
+	return newCloner().clone(reflect.ValueOf(i)).Interface()
+}
+
+type (
+	cloner struct {
+		visited map[cloneKey]reflect.Value
+		origins map[cloneKey]reflect.Value
+	}
+	cloneKey struct {
+		ptr uintptr
+		typ reflect.Type
+		len int
+	}
+)
+
+func newCloner() *cloner {
+	return &cloner{visited: map[cloneKey]reflect.Value{}, origins: map[cloneKey]reflect.Value{}}
+}
+
+// record saves the copy of the reference.
+// Copying the copy again returns itself, so the references are not copied more than once in the same context.
+func (c *cloner) record(key cloneKey, v reflect.Value, out reflect.Value) {
+	outKey := cloneKey{ptr: out.Pointer(), typ: key.typ, len: key.len}
+	c.visited[key] = out
+	c.visited[outKey] = out
+	c.origins[outKey] = v
+}
+
+// origin returns the original reference, if v is a copy made by the cloner.
+func (c *cloner) origin(v reflect.Value) reflect.Value {
+	var key cloneKey
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		key = cloneKey{ptr: v.Pointer(), typ: v.Type()}
+	default:
+		return v
+	}
+	if orig, ok := c.origins[key]; ok {
+		return orig
+	}
+	return v
+}
+
+func (c *cloner) clone(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := cloneKey{ptr: v.Pointer(), typ: v.Type()}
+		if out, ok := c.visited[key]; ok {
+			return out
+		}
+		out := reflect.New(v.Type().Elem())
+		c.record(key, v, out)
+		out.Elem().Set(c.clone(v.Elem()))
+		return out
+	case reflect.Interface:
+		out := reflect.New(v.Type()).Elem()
+		if !v.IsNil() {
+			out.Set(c.clone(v.Elem()))
+		}
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := cloneKey{ptr: v.Pointer(), typ: v.Type(), len: v.Len()}
+		if out, ok := c.visited[key]; ok {
+			return out
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		c.record(key, v, out)
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return out
+	case reflect.Array:
+		out := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := cloneKey{ptr: v.Pointer(), typ: v.Type()}
+		if out, ok := c.visited[key]; ok {
+			return out
+		}
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.record(key, v, out)
+		for _, k := range v.MapKeys() {
+			out.SetMapIndex(c.clone(k), c.clone(v.MapIndex(k)))
+		}
+		return out
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		// NOTE: unexported fields cannot be set individually, so it copies the whole struct first.
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			f := out.Field(i)
+			if !f.CanSet() {
+				continue
+			}
+			if newStructTag(v.Type().Field(i)).ignore {
+				f.Set(reflect.Zero(f.Type()))
+				continue
+			}
+			f.Set(c.clone(v.Field(i)))
+		}
+		return out
+	default:
+		return v
+	}
+}
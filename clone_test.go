@@ -0,0 +1,29 @@
+package henge
+
+import "fmt"
+
+func ExampleClone() {
+	type Node struct {
+		Name     string
+		Children []*Node
+		Parent   *Node
+		Cache    map[string]int `henge:"-"`
+	}
+
+	root := &Node{Name: "root", Cache: map[string]int{"a": 1}}
+	root.Children = []*Node{{Name: "child", Parent: root}}
+
+	cloned := Clone(root).(*Node)
+	cloned.Children[0].Name = "cloned child"
+
+	fmt.Println(root.Children[0].Name)
+	fmt.Println(cloned.Children[0].Name)
+	fmt.Println(cloned.Children[0].Parent == cloned)
+	fmt.Println(cloned.Cache == nil)
+
+	// Output:
+	// child
+	// cloned child
+	// true
+	// true
+}
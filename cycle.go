@@ -0,0 +1,62 @@
+package henge
+
+import (
+	"reflect"
+)
+
+type (
+	// visitKey is a key of the value being converted, that is used to detect cycles.
+	visitKey struct {
+		method  string
+		ptr     uintptr
+		srcType reflect.Type
+		dstType reflect.Type
+	}
+)
+
+// newVisitKey returns a visitKey if the input is a reference that may make a cycle.
+// If the input is a copy made by WithDeepCopy, it returns the key of the original reference.
+func (c *baseConverter) newVisitKey(method string, inV reflect.Value, dstType reflect.Type) (visitKey, bool) {
+	inV = c.cloner.origin(inV)
+	switch inV.Kind() {
+	case reflect.Ptr, reflect.Map:
+		if !inV.IsNil() {
+			return visitKey{method: method, ptr: inV.Pointer(), srcType: inV.Type(), dstType: dstType}, true
+		}
+	}
+	return visitKey{}, false
+}
+
+// enter marks the key as being converted to the output reference, and returns a function to unmark it.
+func (c *baseConverter) enter(key visitKey, ref reflect.Value) func() {
+	c.visiting[key] = ref
+	return func() {
+		delete(c.visiting, key)
+	}
+}
+
+// visited returns the output reference, if the key is being converted.
+func (c *baseConverter) visited(key visitKey) (reflect.Value, bool) {
+	ref, ok := c.visiting[key]
+	return ref, ok
+}
+
+// convertCycle assigns the output reference of the cycle.
+// If WithDeepCopy is not specified or the output cannot hold the reference, it returns ErrCycle.
+func (c *baseConverter) convertCycle(srcValue interface{}, outV reflect.Value, ref reflect.Value) error {
+	if c.opts.copyOpts.deepCopy {
+		for v := outV; ; v = v.Elem() {
+			if v.Type() == ref.Type() {
+				v.Set(ref)
+				return nil
+			}
+			if v.Kind() != reflect.Ptr {
+				break
+			}
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+		}
+	}
+	return c.wrapConvertError(srcValue, outV.Type(), ErrCycle)
+}
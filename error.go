@@ -17,7 +17,23 @@ var (
 	// ErrNegativeNumber is an error if converting a negative number to an unsigned type.
 	ErrNegativeNumber = errors.New("negative number")
 	// ErrNotConvertible is an error, when reflect.Value.Convert needs to use but reflect.Type.ConvertibleTo returns false.
+	// It is also an error when the input does not implement the interface of the output.
 	ErrNotConvertible = errors.New("not convertible")
+	// ErrMultipleElements is an error when unwrapping a slice that has multiple elements.
+	ErrMultipleElements = errors.New("multiple elements")
+	// ErrDuplicateKey is an error when converting to map and the same key appears more than once.
+	// When WithYAML is specified, it is also an error when different keys are converted to the same key.
+	ErrDuplicateKey = errors.New("duplicate key")
+	// ErrCycle is an error when the input has a circular reference.
+	// When WithDeepCopy is specified, the circular reference is kept in the output instead of the error, if possible.
+	ErrCycle = errors.New("circular reference")
+	// ErrLengthMismatch is an error when converting to array and the length of the input is not allowed.
+	// Refer: WithArrayLengthPolicy
+	ErrLengthMismatch = errors.New("length mismatch")
+	// ErrInvalidTag is an error when the tag of the struct field is invalid. (e.g. the same `henge:"index=N"` is tagged more than once)
+	ErrInvalidTag = errors.New("invalid tag")
+	// ErrTrailingData is an error when the JSON data has more data after the top-level value.
+	ErrTrailingData = errors.New("invalid data after top-level value")
 )
 
 type (
@@ -45,7 +61,72 @@ func (e *ConvertError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"Failed to convert from %s to %s: fields=%s, value=%#v, error=%s",
-		srcTypeString, dstTypeString, e.Field, e.Value, e.Err.Error(),
+		"Failed to convert from %s to %s: fields=%s, value=%s, error=%s",
+		srcTypeString, dstTypeString, e.Field, formatValue(e.Value), e.Err.Error(),
 	)
 }
+
+// formatValue returns the string of the value in Go-syntax.
+// If the value has a circular reference, it returns only the type, because formatting it never ends.
+func formatValue(i interface{}) string {
+	v := reflect.ValueOf(i)
+	// NOTE: %#v formats the pointer at the top level as the value.
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if hasCycle(v, map[cloneKey]bool{}) {
+		return fmt.Sprintf("%T{<circular reference>}", i)
+	}
+	return fmt.Sprintf("%#v", i)
+}
+
+// hasCycle returns true if the value refers itself via maps, slices or interfaces.
+// NOTE: Pointers are not followed, because %#v formats the nested pointers as the addresses.
+func hasCycle(v reflect.Value, visiting map[cloneKey]bool) bool {
+	switch v.Kind() {
+	case reflect.Interface:
+		return !v.IsNil() && hasCycle(v.Elem(), visiting)
+	case reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return false
+		}
+		key := cloneKey{ptr: v.Pointer(), typ: v.Type()}
+		if visiting[key] {
+			return true
+		}
+		visiting[key] = true
+		defer delete(visiting, key)
+
+		if v.Kind() == reflect.Map {
+			iter := v.MapRange()
+			for iter.Next() {
+				if hasCycle(iter.Key(), visiting) || hasCycle(iter.Value(), visiting) {
+					return true
+				}
+			}
+			return false
+		}
+		for i := 0; i < v.Len(); i++ {
+			if hasCycle(v.Index(i), visiting) {
+				return true
+			}
+		}
+		return false
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if hasCycle(v.Index(i), visiting) {
+				return true
+			}
+		}
+		return false
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if hasCycle(v.Field(i), visiting) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
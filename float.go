@@ -26,6 +26,8 @@ type (
 // --------------------------------------------------------------------- //
 
 // Float converts the input to float type.
+//
+// json.Number and the types of math/big package (big.Int, big.Float and big.Rat) are converted to the nearest float.
 func (c *ValueConverter) Float() *FloatConverter {
 	var (
 		value float64
@@ -36,10 +38,12 @@ func (c *ValueConverter) Float() *FloatConverter {
 	if inV.IsValid() {
 		inT := inV.Type()
 		outT := reflect.TypeOf(value)
-		if inT.ConvertibleTo(outT) {
+		if isPreciseNumberType(inT) {
+			value, err = c.preciseFloat()
+		} else if inT.ConvertibleTo(outT) {
 			value = inV.Convert(outT).Interface().(float64)
 		} else if inT.Kind() == reflect.String {
-			value, err = strconv.ParseFloat(inV.Interface().(string), 64)
+			value, err = strconv.ParseFloat(inV.String(), 64)
 		} else if inT.Kind() == reflect.Bool {
 			if inV.Interface().(bool) == true {
 				value = 1
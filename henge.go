@@ -51,10 +51,12 @@ type (
 
 	// baseConverter is a struct inherited by each Converter and has common functions.
 	baseConverter struct {
-		isNil   bool
-		field   string
-		opts    *converterOpts
-		storage map[string]interface{}
+		isNil    bool
+		field    string
+		opts     *converterOpts
+		storage  map[string]interface{}
+		cloner   *cloner
+		visiting map[visitKey]reflect.Value
 	}
 )
 
@@ -63,6 +65,8 @@ func (c *baseConverter) new(i interface{}, fieldName string) *ValueConverter {
 	newConverter.baseConverter.field = fieldName
 	newConverter.baseConverter.opts = c.opts
 	newConverter.baseConverter.storage = c.storage
+	newConverter.baseConverter.cloner = c.cloner
+	newConverter.baseConverter.visiting = c.visiting
 	return newConverter
 }
 
@@ -83,6 +87,14 @@ func (c *baseConverter) InstanceSetValues(m map[string]interface{}) {
 	}
 }
 
+// copyValue returns a deep copy of the value if WithDeepCopy is specified, otherwise it returns the value as is.
+func (c *baseConverter) copyValue(i interface{}) interface{} {
+	if !c.opts.copyOpts.deepCopy || i == nil {
+		return i
+	}
+	return c.cloner.clone(reflect.ValueOf(i)).Interface()
+}
+
 func (c *baseConverter) wrapConvertError(srcValue interface{}, dstType reflect.Type, err error) error {
 	if convertErr, ok := err.(*ConvertError); ok {
 		err := *convertErr
@@ -136,9 +148,11 @@ func New(i interface{}, fs ...ConverterOption) *ValueConverter {
 
 	return &ValueConverter{
 		baseConverter: &baseConverter{
-			isNil:   isNil,
-			opts:    opts,
-			storage: map[string]interface{}{},
+			isNil:    isNil,
+			opts:     opts,
+			storage:  map[string]interface{}{},
+			cloner:   newCloner(),
+			visiting: map[visitKey]reflect.Value{},
 		},
 		reflectValue: reflectValue,
 		value:        i,
@@ -208,6 +222,32 @@ func (c *ValueConverter) convert(outV reflect.Value) error {
 		outT = outT.Elem()
 	}
 
+	inV := reflect.Indirect(c.reflectValue)
+	if inV.IsValid() && inV.Type() == jsonRawMessageType && !c.isNil && isRawMessageTarget(outT) {
+		value, err := c.decodeRawMessage()
+		if err != nil {
+			return c.wrapConvertError(c.value, outV.Type(), err)
+		}
+		return c.new(value, c.field).convert(outV)
+	}
+	if isBigNumberType(outT) {
+		return c.convertBigNumber(outV)
+	}
+	if c.opts.sliceOpts.unwrapSingleton && c.isUnwrappable(inV, outT) {
+		switch inV.Len() {
+		case 0:
+			// NOTE: A nil slice leaves the output untouched as with other nil inputs, but an empty slice resets it.
+			if !c.isNil {
+				outV.Set(reflect.Zero(outV.Type()))
+			}
+			return nil
+		case 1:
+			return c.new(inV.Index(0).Interface(), c.field+"[0]").convert(outV)
+		default:
+			return c.wrapConvertError(c.value, outV.Type(), ErrMultipleElements)
+		}
+	}
+
 	switch outT.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return c.Int().convert(outV)
@@ -220,27 +260,94 @@ func (c *ValueConverter) convert(outV reflect.Value) error {
 	case reflect.String:
 		return c.String().convert(outV)
 	case reflect.Array, reflect.Slice:
+		if isBytesType(outT) && inV.Kind() == reflect.String {
+			return c.Bytes().convert(outV)
+		}
+		if inV.Kind() == reflect.Map && c.isKeyedSliceType(outT) {
+			return c.new(sortedMapValues(inV), c.field).Slice().convert(outV)
+		}
+		if c.opts.sliceOpts.wrapScalar && c.isWrappable(inV) {
+			if c.isNil {
+				return nil
+			}
+			return c.new([]interface{}{c.value}, c.field).Slice().convert(outV)
+		}
 		return c.Slice().convert(outV)
 	case reflect.Map, reflect.Struct:
 		t := reflect.ValueOf(c.value).Type()
 		for t.Kind() == reflect.Ptr {
 			t = t.Elem()
 		}
+		if key, ok := c.newVisitKey("convert", c.reflectValue, outT); ok && !c.isNil && (t.Kind() == reflect.Map || t.Kind() == reflect.Struct) {
+			if ref, ok := c.visited(key); ok {
+				return c.convertCycle(c.value, outV, ref)
+			}
+			elemOutV := toInitializedNonPtrValue(outV)
+			if elemOutV.Kind() == reflect.Map {
+				if elemOutV.IsNil() {
+					elemOutV.Set(reflect.MakeMap(elemOutV.Type()))
+				}
+				defer c.enter(key, elemOutV)()
+			} else {
+				defer c.enter(key, elemOutV.Addr())()
+			}
+		}
 		if t.Kind() == reflect.Map {
 			return c.Map().convert(outV)
 		}
+		if t.Kind() == reflect.Array || t.Kind() == reflect.Slice {
+			return c.Slice().convert(outV)
+		}
 		return c.Struct().convert(outV)
 	case reflect.Interface:
-		for outV.Kind() == reflect.Ptr {
-			outV = outV.Elem()
-		}
-		outV.Set(reflect.ValueOf(c.value))
-		return nil
+		return c.convertInterface(toInitializedNonPtrValue(outV))
 	default:
 		return c.wrapConvertError(c.value, outV.Type(), ErrUnsupportedType)
 	}
 }
 
+// isWrappable returns true if the input can be wrapped in a single-element slice.
+func (c *ValueConverter) isWrappable(inV reflect.Value) bool {
+	switch inV.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map:
+		// NOTE: a map is converted to a slice of key-value pairs.
+		return false
+	case reflect.String:
+		return c.opts.sliceOpts.separator == ""
+	default:
+		return true
+	}
+}
+
+// isKeyedSliceType returns true if the out type is a slice of structs having the key field.
+func (c *ValueConverter) isKeyedSliceType(outT reflect.Type) bool {
+	elemT := outT.Elem()
+	for elemT.Kind() == reflect.Ptr {
+		elemT = elemT.Elem()
+	}
+	if elemT.Kind() != reflect.Struct {
+		return false
+	}
+	_, ok := getStructKeyField(elemT, c.opts.sliceOpts.mapKeyField)
+	return ok
+}
+
+// isUnwrappable returns true if the input is a slice that can be unwrapped to the out type.
+func (c *ValueConverter) isUnwrappable(inV reflect.Value, outT reflect.Type) bool {
+	if inV.Kind() != reflect.Array && inV.Kind() != reflect.Slice {
+		return false
+	}
+	switch outT.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map, reflect.Interface:
+		// NOTE: a slice is converted to a map from key-value pairs.
+		return false
+	case reflect.String:
+		return !isBytesType(inV.Type()) && c.opts.sliceOpts.separator == ""
+	default:
+		return true
+	}
+}
+
 // Result returns the conversion result and error.
 func (c *ValueConverter) Result() (interface{}, error) {
 	return c.value, c.err
@@ -261,6 +368,24 @@ func (c *ValueConverter) Error() error {
 	return c.err
 }
 
+// mergeInto converts the input to the out type and assigns it.
+// If WithMerge is specified and the output is an interface holding a map or a struct, the input is merged into it.
+func (c *ValueConverter) mergeInto(outV reflect.Value) error {
+	if c.opts.copyOpts.merge && outV.Kind() == reflect.Interface && !outV.IsNil() && !c.isNil {
+		inKind, outKind := reflect.Indirect(c.reflectValue).Kind(), reflect.Indirect(outV.Elem()).Kind()
+		if (inKind == reflect.Map || inKind == reflect.Struct) && (outKind == reflect.Map || outKind == reflect.Struct) {
+			v := reflect.New(outV.Elem().Type()).Elem()
+			v.Set(outV.Elem())
+			if err := c.convert(v); err != nil {
+				return err
+			}
+			outV.Set(v)
+			return nil
+		}
+	}
+	return c.convert(outV)
+}
+
 func toInitializedNonPtrValue(v reflect.Value) reflect.Value {
 	for v.Kind() == reflect.Ptr {
 		if v.IsNil() {
@@ -27,6 +27,9 @@ type (
 // --------------------------------------------------------------------- //
 
 // Int converts the input to int type.
+//
+// json.Number and the types of math/big package (big.Int, big.Float and big.Rat) are converted exactly,
+// and the fractional part is rounded by the function specified by WithRoundingFunc.
 func (c *ValueConverter) Int() *IntegerConverter {
 	var (
 		value int64
@@ -63,7 +66,17 @@ func (c *ValueConverter) Int() *IntegerConverter {
 				value = 1
 			}
 		case reflect.String:
-			value, err = strconv.ParseInt(inV.Interface().(string), 10, 64)
+			if inT == jsonNumberType {
+				value, err = c.preciseInt()
+			} else {
+				value, err = strconv.ParseInt(inV.String(), 10, 64)
+			}
+		case reflect.Struct:
+			if isBigNumberType(inT) {
+				value, err = c.preciseInt()
+			} else {
+				err = ErrUnsupportedType
+			}
 		default:
 			err = ErrUnsupportedType
 		}
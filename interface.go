@@ -0,0 +1,158 @@
+package henge
+
+import (
+	"reflect"
+	"sort"
+)
+
+type (
+	// implementations is the concrete types of an interface selected by the discriminator field.
+	implementations struct {
+		discriminator string
+		types         map[string]reflect.Type
+	}
+)
+
+// resolveImplementation returns the concrete type selected by the discriminator field of the input.
+// It returns false if no implementations are registered for the interface, or the input is not a map.
+func (c *ValueConverter) resolveImplementation(ifaceType reflect.Type) (reflect.Type, bool, error) {
+	inV := reflect.Indirect(c.reflectValue)
+	if inV.Kind() != reflect.Map {
+		return nil, false, nil
+	}
+
+	if impls, ok := c.opts.interfaceOpts.implementations[ifaceType]; ok {
+		name, ok, err := c.discriminatorValue(inV, impls.discriminator)
+		if err != nil {
+			return nil, true, err
+		}
+		if t, found := impls.types[name]; ok && found {
+			return t, true, nil
+		}
+		return nil, true, c.wrapConvertError(c.value, ifaceType, ErrNotConvertible)
+	}
+
+	// NOTE: The discriminators registered by WithDiscriminator are used only if the selected type implements the interface.
+	//       interface{} is excluded, so that the untyped data are kept as is.
+	if ifaceType.NumMethod() == 0 {
+		return nil, false, nil
+	}
+	for _, impls := range c.opts.interfaceOpts.discriminators {
+		name, ok, err := c.discriminatorValue(inV, impls.discriminator)
+		if err != nil {
+			return nil, true, err
+		}
+		if t, found := impls.types[name]; ok && found && t.Implements(ifaceType) {
+			return t, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// discriminatorValue returns the value of the discriminator field of the input map.
+// It returns false if the field is missing.
+func (c *ValueConverter) discriminatorValue(inV reflect.Value, discriminator string) (string, bool, error) {
+	for _, key := range inV.MapKeys() {
+		if New(key.Interface()).String().Value() != discriminator {
+			continue
+		}
+		name, err := c.new(inV.MapIndex(key).Interface(), c.field+"."+discriminator).String().Result()
+		if err != nil {
+			return "", false, err
+		}
+		return name, true, nil
+	}
+	return "", false, nil
+}
+
+// discriminatorOf returns the discriminator field and its value of the type, that are registered by WithDiscriminator or WithImplementations.
+// It returns false if the type is not registered.
+func (c *baseConverter) discriminatorOf(t reflect.Type) (string, string, bool) {
+	find := func(impls implementations) (string, bool) {
+		// NOTE: The names are sorted, so that the result is deterministic if multiple names have the type.
+		names := make([]string, 0, len(impls.types))
+		for name := range impls.types {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if implType := impls.types[name]; implType == t || implType == reflect.PtrTo(t) {
+				return name, true
+			}
+		}
+		return "", false
+	}
+
+	for _, impls := range c.opts.interfaceOpts.discriminators {
+		if name, ok := find(impls); ok {
+			return impls.discriminator, name, true
+		}
+	}
+	ifaceTypes := make([]reflect.Type, 0, len(c.opts.interfaceOpts.implementations))
+	for ifaceType := range c.opts.interfaceOpts.implementations {
+		ifaceTypes = append(ifaceTypes, ifaceType)
+	}
+	sort.Slice(ifaceTypes, func(i, j int) bool {
+		return ifaceTypes[i].String() < ifaceTypes[j].String()
+	})
+	for _, ifaceType := range ifaceTypes {
+		impls := c.opts.interfaceOpts.implementations[ifaceType]
+		if name, ok := find(impls); ok {
+			return impls.discriminator, name, true
+		}
+	}
+	return "", "", false
+}
+
+// convertInterface converts the input to the interface type and assigns it.
+// If the input does not implement the interface, it returns ErrNotConvertible.
+func (c *ValueConverter) convertInterface(outV reflect.Value) error {
+	if t, ok, err := c.resolveImplementation(outV.Type()); err != nil {
+		return err
+	} else if ok {
+		v := reflect.New(t).Elem()
+		if err := c.convert(v); err != nil {
+			return err
+		}
+		if !v.Type().AssignableTo(outV.Type()) {
+			return c.wrapConvertError(c.value, outV.Type(), ErrNotConvertible)
+		}
+		outV.Set(v)
+		return nil
+	}
+
+	if c.opts.interfaceOpts.normalize && outV.NumMethod() == 0 {
+		if c.isNil {
+			outV.Set(reflect.Zero(outV.Type()))
+			return nil
+		}
+		v, err := c.JSONValue().Result()
+		if err != nil {
+			return err
+		}
+		outV.Set(reflect.ValueOf(&v).Elem())
+		return nil
+	}
+
+	if c.opts.mapOpts.yaml && outV.NumMethod() == 0 {
+		if ok, err := c.convertYAMLInterface(outV); ok {
+			return err
+		}
+	}
+
+	v := reflect.ValueOf(c.copyValue(c.value))
+	switch {
+	case !v.IsValid():
+		outV.Set(reflect.Zero(outV.Type()))
+	case v.Type().AssignableTo(outV.Type()):
+		outV.Set(v)
+	case reflect.PtrTo(v.Type()).AssignableTo(outV.Type()):
+		// NOTE: The methods with pointer receiver are available.
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		outV.Set(ptr)
+	default:
+		return c.wrapConvertError(c.value, outV.Type(), ErrNotConvertible)
+	}
+	return nil
+}
@@ -1,6 +1,14 @@
 package henge
 
-import "reflect"
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+var (
+	jsonRawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
 
 type (
 	// JSONValueConverter is a converter that converts a JSON value type to another type.
@@ -19,17 +27,66 @@ type (
 	}
 )
 
+// UnmarshalJSON decodes the JSON data and converts it to the out type and assigns it.
+//
+// Unlike json.Unmarshal, the values are converted with henge, so that a string "1" can be assigned to an int field and so on.
+// The numbers are decoded to json.Number, so that the precision is not lost.
+// If the data has more data after the top-level value, it returns ErrTrailingData.
+// If the conversion fails, it returns ConvertError that has the path of the field.
+//
+// NOTE: The data is not streamed. It is decoded to the generic JSON values (map[string]interface{}, []interface{} and so on) once,
+// and then the values are converted, so that all conversion rules and options of henge are applied in the same way as New.
+func UnmarshalJSON(data []byte, out interface{}, fs ...ConverterOption) error {
+	value, err := decodeJSON(data)
+	if err != nil {
+		return err
+	}
+	return New(value, fs...).Convert(out)
+}
+
+// MarshalJSON converts the input to JSON value and returns the JSON encoding of it.
+//
+// NOTE: The input is converted to the generic JSON values once, and then they are encoded.
+//
+// Refer: JSONValue
+func MarshalJSON(i interface{}, fs ...ConverterOption) ([]byte, error) {
+	value, err := New(i, fs...).JSONValue().Result()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
 // --------------------------------------------------------------------- //
 // ValueConverter
 // --------------------------------------------------------------------- //
 
 // JSONValue converts the input to JSON value (boolean or string or numeric or array or map)
+//
+// json.Number and the types of math/big package are converted to int64 or uint64 if it is an integer in the range, and otherwise to float64.
+// json.RawMessage is decoded before converting.
 func (c *ValueConverter) JSONValue() *JSONValueConverter {
 	if c.isNil {
 		return &JSONValueConverter{Converter: c}
 	}
 
-	switch reflect.Indirect(c.reflectValue).Kind() {
+	inT := reflect.Indirect(c.reflectValue).Type()
+	if isPreciseNumberType(inT) {
+		value, err := c.preciseValue()
+		if err != nil {
+			err = c.wrapConvertError(c.value, interfaceType, err)
+		}
+		return &JSONValueConverter{Converter: &ValueConverter{baseConverter: c.baseConverter, reflectValue: reflect.ValueOf(value), value: value, err: err}}
+	}
+	if inT == jsonRawMessageType {
+		value, err := c.decodeRawMessage()
+		if err != nil {
+			return &JSONValueConverter{Converter: &ValueConverter{baseConverter: c.baseConverter, reflectValue: reflect.ValueOf(value), value: value, err: err}}
+		}
+		return c.new(value, c.field).JSONValue()
+	}
+
+	switch inT.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return &JSONValueConverter{Converter: c.Int()}
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
@@ -39,6 +96,9 @@ func (c *ValueConverter) JSONValue() *JSONValueConverter {
 	case reflect.Bool:
 		return &JSONValueConverter{Converter: c.Bool()}
 	case reflect.Array, reflect.Slice:
+		if c.opts.bytesOpts.encodeFunc != nil && isBytesType(reflect.Indirect(c.reflectValue).Type()) {
+			return &JSONValueConverter{Converter: c.String()}
+		}
 		return &JSONValueConverter{Converter: c.JSONArray()}
 	case reflect.Map, reflect.Struct:
 		return &JSONValueConverter{Converter: c.JSONObject()}
@@ -73,6 +133,43 @@ func (c *ValueConverter) JSONObject() *JSONObjectConverter {
 	return &JSONObjectConverter{baseConverter: newConv.baseConverter, value: out, err: err}
 }
 
+// decodeRawMessage decodes the input of json.RawMessage.
+// The numbers are decoded to json.Number, so that the precision is not lost.
+func (c *ValueConverter) decodeRawMessage() (interface{}, error) {
+	value, err := decodeJSON(reflect.Indirect(c.reflectValue).Bytes())
+	if err != nil {
+		return nil, c.wrapConvertError(c.value, interfaceType, err)
+	}
+	return value, nil
+}
+
+// decodeJSON decodes the JSON data that has exactly one top-level value.
+// The numbers are decoded to json.Number, so that the precision is not lost.
+func decodeJSON(data []byte) (interface{}, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if len(bytes.Trim(data[decoder.InputOffset():], " \t\r\n")) > 0 {
+		return nil, ErrTrailingData
+	}
+	return value, nil
+}
+
+// isRawMessageTarget returns true if json.RawMessage is decoded before converting to the type.
+func isRawMessageTarget(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Map, reflect.Struct:
+		return !isBigNumberType(t)
+	case reflect.Array, reflect.Slice:
+		return !isBytesType(t)
+	default:
+		return false
+	}
+}
+
 // --------------------------------------------------------------------- //
 // JSONValueConverter
 // --------------------------------------------------------------------- //
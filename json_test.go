@@ -142,3 +142,35 @@ func ExampleValueConverter_JSONArray() {
 	// [3] map[interface {}]interface {}{1:henge.Y{Z:"z"}} -> map[string]interface {}{"1":map[string]interface {}{"Z":"z"}}
 	// [4] henge.Object{X:henge.X{Y:henge.Y{Z:"z"}}} -> map[string]interface {}{"X":map[string]interface {}{"Y":map[string]interface {}{"Z":"z"}}}
 }
+
+func ExampleUnmarshalJSON() {
+	type User struct {
+		ID   int64
+		Name string
+		Tags []string
+	}
+
+	var user User
+	_ = UnmarshalJSON([]byte(`{"ID": "9007199254740993", "Name": 1, "Tags": ["a", 2]}`), &user)
+	fmt.Printf("%+v\n", user)
+
+	err := UnmarshalJSON([]byte(`{"ID": "a"}`), &user)
+	fmt.Println(err)
+
+	// Output:
+	// {ID:9007199254740993 Name:1 Tags:[a 2]}
+	// Failed to convert from string to int64: fields=.ID, value="a", error=strconv.ParseInt: parsing "a": invalid syntax
+}
+
+func ExampleMarshalJSON() {
+	type User struct {
+		ID   int64
+		Name string
+	}
+
+	b, _ := MarshalJSON([]*User{{ID: 1, Name: "a"}, nil})
+	fmt.Println(string(b))
+
+	// Output:
+	// [{"ID":1,"Name":"a"},null]
+}
@@ -1,6 +1,9 @@
 package henge
 
-import "reflect"
+import (
+	"reflect"
+	"sort"
+)
 
 type (
 	// MapConverter is a converter that converts a map type to another type.
@@ -9,13 +12,96 @@ type (
 		value reflect.Value
 		err   error
 	}
+
+	// StringMapConverter is a converter that converts a map of string key type to another type.
+	StringMapConverter struct {
+		*baseConverter
+		value map[string]interface{}
+		err   error
+	}
+
+	// StringStringMapConverter is a converter that converts a map of string key and string value type to another type.
+	StringStringMapConverter struct {
+		*baseConverter
+		value map[string]string
+		err   error
+	}
+
+	// StringIntMapConverter is a converter that converts a map of string key and integer value type to another type.
+	StringIntMapConverter struct {
+		*baseConverter
+		value map[string]int64
+		err   error
+	}
+
+	// StringUintMapConverter is a converter that converts a map of string key and uint value type to another type.
+	StringUintMapConverter struct {
+		*baseConverter
+		value map[string]uint64
+		err   error
+	}
+
+	// StringFloatMapConverter is a converter that converts a map of string key and float value type to another type.
+	StringFloatMapConverter struct {
+		*baseConverter
+		value map[string]float64
+		err   error
+	}
+
+	// StringBoolMapConverter is a converter that converts a map of string key and bool value type to another type.
+	StringBoolMapConverter struct {
+		*baseConverter
+		value map[string]bool
+		err   error
+	}
+
+	// KeyValue is a pair of key and value.
+	// It is used as an element when converting between map and slice.
+	KeyValue struct {
+		Key   interface{}
+		Value interface{}
+	}
 )
 
+// --------------------------------------------------------------------- //
+// ValueConverter
+// --------------------------------------------------------------------- //
+
 // Map converts the input to map type.
 func (c *ValueConverter) Map() *MapConverter {
 	return c.mapWithDepth(0)
 }
 
+// StringMap converts the input to map of string key type.
+func (c *ValueConverter) StringMap() *StringMapConverter {
+	return c.Map().StringMap()
+}
+
+// StringStringMap converts the input to map of string key and string value type.
+func (c *ValueConverter) StringStringMap() *StringStringMapConverter {
+	return c.Map().StringStringMap()
+}
+
+// StringIntMap converts the input to map of string key and int value type.
+func (c *ValueConverter) StringIntMap() *StringIntMapConverter {
+	return c.Map().StringIntMap()
+}
+
+// StringUintMap converts the input to map of string key and uint value type.
+func (c *ValueConverter) StringUintMap() *StringUintMapConverter {
+	return c.Map().StringUintMap()
+}
+
+// StringFloatMap converts the input to map of string key and float value type.
+func (c *ValueConverter) StringFloatMap() *StringFloatMapConverter {
+	return c.Map().StringFloatMap()
+}
+
+// StringBoolMap converts the input to map of string key and bool value type.
+func (c *ValueConverter) StringBoolMap() *StringBoolMapConverter {
+	return c.Map().StringBoolMap()
+}
+
 func (c *ValueConverter) makeOutputMapVar() reflect.Value {
 	return reflect.New(reflect.MapOf(c.opts.mapOpts.keyType, interfaceType)).Elem()
 }
@@ -33,8 +119,15 @@ func (c *ValueConverter) mapWithDepth(depth uint) *MapConverter {
 		if !c.opts.mapOpts.filterFuns.All(kVal.Interface(), vVal.Interface()) {
 			return
 		}
-		strKey := New(kVal.Interface()).String().Value()
-		kConv := c.opts.mapOpts.keyConversionFunc(c.new(kVal.Interface(), c.field+"[]"+strKey))
+		key := kVal.Interface()
+		if c.opts.mapOpts.keyType.Kind() == reflect.String {
+			if key, err = c.formatMapKey(key); err != nil {
+				err = c.new(kVal.Interface(), c.field+"[]").wrapConvertError(kVal.Interface(), c.opts.mapOpts.keyType, err)
+				return
+			}
+		}
+		strKey := New(key).String().Value()
+		kConv := c.opts.mapOpts.keyConversionFunc(c.new(key, c.field+"[]"+strKey))
 		if err = kConv.Error(); err != nil {
 			return
 		}
@@ -49,6 +142,10 @@ func (c *ValueConverter) mapWithDepth(depth uint) *MapConverter {
 				return reflect.ValueOf(k).Convert(c.opts.keyType)
 			}
 		})()
+		if c.opts.mapOpts.yaml && value.MapIndex(convertedKeyVal).IsValid() {
+			err = c.new(kVal.Interface(), c.field+"[]"+strKey).wrapConvertError(kVal.Interface(), value.Type(), ErrDuplicateKey)
+			return
+		}
 
 		switch reflect.Indirect(reflect.ValueOf(vVal.Interface())).Kind() {
 		case reflect.Struct:
@@ -72,30 +169,53 @@ func (c *ValueConverter) mapWithDepth(depth uint) *MapConverter {
 			if err = vConv.Error(); err != nil {
 				return
 			}
-			v := vConv.Interface()
+			v := c.copyValue(vConv.Interface())
 			value.SetMapIndex(convertedKeyVal, reflect.ValueOf(&v).Elem())
 		}
 	}
 
+	visitKey, isRef := c.newVisitKey("map", c.reflectValue, value.Type())
+	if ref, ok := c.visited(visitKey); isRef && ok {
+		if c.opts.copyOpts.deepCopy {
+			return &MapConverter{baseConverter: c.baseConverter, value: ref, err: nil}
+		}
+		return &MapConverter{baseConverter: c.baseConverter, value: value, err: c.wrapConvertError(c.value, value.Type(), ErrCycle)}
+	}
+
 	inV := reflect.Indirect(c.reflectValue)
 	switch inV.Kind() {
 	case reflect.Map:
 		value = reflect.MakeMap(value.Type())
-		iter := inV.MapRange()
-		for iter.Next() {
-			convAndSet(iter.Key(), iter.Value())
+		if isRef {
+			defer c.enter(visitKey, value)()
+		}
+		for _, key := range c.mapKeys(inV) {
+			convAndSet(key, inV.MapIndex(key))
 			if err != nil {
 				break
 			}
 		}
 	case reflect.Struct:
 		value = reflect.MakeMap(value.Type())
+		if isRef {
+			defer c.enter(visitKey, value)()
+		}
 		for i := 0; i < inV.NumField(); i++ {
-			convAndSet(reflect.ValueOf(inV.Type().Field(i).Name), inV.Field(i))
+			if tag := newStructTag(inV.Type().Field(i)); !tag.isVisible(c.opts.structOpts.groups) {
+				continue
+			}
+			name, ok := c.yamlFieldName(inV.Type(), structField{name: inV.Type().Field(i).Name, index: []int{i}})
+			if !ok {
+				continue
+			}
+			convAndSet(reflect.ValueOf(name), inV.Field(i))
 			if err != nil {
 				break
 			}
 		}
+		if field, name, ok := c.discriminatorOf(inV.Type()); ok && err == nil {
+			convAndSet(reflect.ValueOf(field), reflect.ValueOf(name))
+		}
 	default:
 		err = ErrUnsupportedType
 	}
@@ -107,6 +227,85 @@ func (c *ValueConverter) mapWithDepth(depth uint) *MapConverter {
 	return &MapConverter{baseConverter: c.baseConverter, value: value, err: err}
 }
 
+// convertMapKey converts the input to the key type of the map and assigns it.
+// Refer: WithMapKeyParser and WithMapKeyFormatter
+func (c *ValueConverter) convertMapKey(outV reflect.Value) error {
+	inV := reflect.Indirect(c.reflectValue)
+	if parse, ok := c.opts.mapOpts.keyParseFuncs[outV.Type()]; ok && inV.Kind() == reflect.String {
+		key, err := parse(inV.String())
+		if err != nil {
+			return c.wrapConvertError(c.value, outV.Type(), err)
+		}
+		return c.new(key, c.field).convert(outV)
+	}
+	if outV.Kind() == reflect.String {
+		key, err := c.formatMapKey(c.value)
+		if err != nil {
+			return c.wrapConvertError(c.value, outV.Type(), err)
+		}
+		return c.new(key, c.field).convert(outV)
+	}
+	return c.convert(outV)
+}
+
+// formatMapKey returns the string key formatted by WithMapKeyFormatter, if it is registered for the type of the key.
+// Otherwise, it returns the key as is.
+func (c *baseConverter) formatMapKey(key interface{}) (interface{}, error) {
+	format, ok := c.opts.mapOpts.keyFormatFuncs[reflect.TypeOf(key)]
+	if !ok {
+		return key, nil
+	}
+	return format(key)
+}
+
+// mapKeys returns the keys of the map in the order of iteration.
+// If WithSortedMapKeys is specified, the keys are sorted.
+func (c *baseConverter) mapKeys(m reflect.Value) []reflect.Value {
+	if c.opts.mapOpts.sortKeys {
+		return sortedMapKeys(m)
+	}
+	return m.MapKeys()
+}
+
+// sortedMapKeys returns the keys of the map in ascending order of the strings converted with String.
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return lessMapKey(keys[i].Interface(), keys[j].Interface())
+	})
+	return keys
+}
+
+// sortedMapValues returns the values of the map in ascending order of the keys.
+func sortedMapValues(m reflect.Value) []interface{} {
+	keys := sortedMapKeys(m)
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		values[i] = m.MapIndex(key).Interface()
+	}
+	return values
+}
+
+func lessMapKey(a, b interface{}) bool {
+	as, bs := New(a).String().Value(), New(b).String().Value()
+	if as != bs {
+		return as < bs
+	}
+	// NOTE: If the keys are equal as strings (e.g. 1 and "1"), it compares the type names.
+	return typeName(a) < typeName(b)
+}
+
+func typeName(i interface{}) string {
+	if i == nil {
+		return ""
+	}
+	return reflect.TypeOf(i).String()
+}
+
+// --------------------------------------------------------------------- //
+// MapConverter
+// --------------------------------------------------------------------- //
+
 // Convert converts the input to the out type and assigns it.
 // If the conversion fails, the method returns an error.
 func (c *MapConverter) Convert(out interface{}) error {
@@ -137,50 +336,94 @@ func (c *MapConverter) convert(outV reflect.Value) error {
 		if outV.IsNil() {
 			outV.Set(reflect.MakeMap(outV.Type()))
 		}
-		iter := c.value.MapRange()
-		for iter.Next() {
-			keyV := reflect.New(outV.Type().Key())
-			valueV := reflect.New(outV.Type().Elem())
-			strKey := New(keyV).String().Value()
-			if err := c.new(iter.Key().Interface(), c.field+"[]"+strKey).convert(keyV); err != nil {
+		seen := reflect.MakeMap(reflect.MapOf(outV.Type().Key(), reflect.TypeOf(true)))
+		for _, key := range c.mapKeys(c.value) {
+			keyV := reflect.New(outV.Type().Key()).Elem()
+			valueV := reflect.New(outV.Type().Elem()).Elem()
+			value := c.value.MapIndex(key).Interface()
+			strKey := New(key.Interface()).String().Value()
+			if err := c.new(key.Interface(), c.field+"[]"+strKey).convertMapKey(keyV); err != nil {
 				return err
 			}
-			if err := c.new(iter.Value().Interface(), c.field+"["+strKey+"]").convert(valueV); err != nil {
+			if c.opts.mapOpts.yaml {
+				if seen.MapIndex(keyV).IsValid() {
+					return c.new(key.Interface(), c.field+"[]"+strKey).wrapConvertError(key.Interface(), keyV.Type(), ErrDuplicateKey)
+				}
+				seen.SetMapIndex(keyV, reflect.ValueOf(true))
+			}
+			if c.opts.copyOpts.merge {
+				if isZero(value) {
+					continue
+				}
+				if v := outV.MapIndex(keyV); v.IsValid() {
+					valueV.Set(v)
+				}
+			}
+			if err := c.new(value, c.field+"["+strKey+"]").mergeInto(valueV); err != nil {
 				return err
 			}
-			outV.SetMapIndex(keyV.Elem(), valueV.Elem())
+			outV.SetMapIndex(keyV, valueV)
 		}
 	case reflect.Struct:
 		m := map[string]interface{}{}
-		iter := c.value.MapRange()
-		for iter.Next() {
-			strKey, err := c.new(iter.Key().Interface(), c.field+"[]").String().Result()
+		keys := make([]string, 0, c.value.Len())
+		for _, key := range c.mapKeys(c.value) {
+			strKey, err := c.new(key.Interface(), c.field+"[]").String().Result()
 			if err != nil {
 				return err
 			}
-			m[strKey] = iter.Value().Interface()
+			if _, ok := m[strKey]; ok && c.opts.mapOpts.yaml {
+				return c.new(key.Interface(), c.field+"[]"+strKey).wrapConvertError(key.Interface(), outV.Type(), ErrDuplicateKey)
+			}
+			m[strKey] = c.value.MapIndex(key).Interface()
+			keys = append(keys, strKey)
 		}
 
 		for _, outField := range getStructFields(outV.Type()) {
-			if outField.isIgnore() {
+			if outField.isIgnore() || !outField.isVisible(c.opts.structOpts.groups) {
 				continue
 			}
 
-			if value, ok := m[outField.name]; ok {
+			name, ok := c.yamlFieldName(outV.Type(), outField)
+			if !ok {
+				continue
+			}
+			if value, ok := m[name]; ok && !c.isOmitted(outField, value) && c.isCopyableField(outV.Type(), outField, value) {
 				// NOTE: initialized embedded field.
-				anchor := outV
-				for _, index := range outField.index {
-					v := anchor.Field(index)
-					if v.Kind() == reflect.Ptr {
-						if v.IsNil() {
-							v.Set(reflect.New(v.Type().Elem()))
+				anchor, target, ok := outV, reflect.Value{}, true
+				for i, index := range outField.index {
+					target, ok = c.accessibleField(anchor.Field(index))
+					// NOTE: The exported fields promoted through the unexported embedded struct are accessible,
+					//       so only the last field and the nil pointer to be initialized are checked.
+					if !ok && (i == len(outField.index)-1 || (target.Kind() == reflect.Ptr && target.IsNil())) {
+						break
+					}
+					ok = true
+					anchor = target
+					if target.Kind() == reflect.Ptr {
+						if target.IsNil() {
+							target.Set(reflect.New(target.Type().Elem()))
 						}
-						anchor = v.Elem()
+						anchor = target.Elem()
 					}
 				}
+				// NOTE: private field
+				if !ok {
+					continue
+				}
+
+				if err := c.new(value, c.field+"."+outField.name).mergeInto(target); err != nil {
+					return err
+				}
+			}
+		}
 
-				target := outV.FieldByIndex(outField.index)
-				if err := c.new(value, c.field+"."+outField.name).convert(target); err != nil {
+		if c.opts.structOpts.setterMethods {
+			for _, key := range keys {
+				if hasExportedField(outV.Type(), key) {
+					continue
+				}
+				if err := c.callSetter(outV, key, m[key]); err != nil {
 					return err
 				}
 			}
@@ -191,6 +434,60 @@ func (c *MapConverter) convert(outV reflect.Value) error {
 	return nil
 }
 
+// StringMap converts the input to map of string key type.
+func (c *MapConverter) StringMap() *StringMapConverter {
+	var value map[string]interface{}
+	if err := c.Convert(&value); err != nil {
+		return &StringMapConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &StringMapConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// StringStringMap converts the input to map of string key and string value type.
+func (c *MapConverter) StringStringMap() *StringStringMapConverter {
+	var value map[string]string
+	if err := c.Convert(&value); err != nil {
+		return &StringStringMapConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &StringStringMapConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// StringIntMap converts the input to map of string key and int value type.
+func (c *MapConverter) StringIntMap() *StringIntMapConverter {
+	var value map[string]int64
+	if err := c.Convert(&value); err != nil {
+		return &StringIntMapConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &StringIntMapConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// StringUintMap converts the input to map of string key and uint value type.
+func (c *MapConverter) StringUintMap() *StringUintMapConverter {
+	var value map[string]uint64
+	if err := c.Convert(&value); err != nil {
+		return &StringUintMapConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &StringUintMapConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// StringFloatMap converts the input to map of string key and float value type.
+func (c *MapConverter) StringFloatMap() *StringFloatMapConverter {
+	var value map[string]float64
+	if err := c.Convert(&value); err != nil {
+		return &StringFloatMapConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &StringFloatMapConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// StringBoolMap converts the input to map of string key and bool value type.
+func (c *MapConverter) StringBoolMap() *StringBoolMapConverter {
+	var value map[string]bool
+	if err := c.Convert(&value); err != nil {
+		return &StringBoolMapConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &StringBoolMapConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
 // Result returns the conversion result and error.
 func (c *MapConverter) Result() (map[interface{}]interface{}, error) {
 	if c.isNil {
@@ -219,3 +516,147 @@ func (c *MapConverter) Interface() interface{} {
 func (c *MapConverter) Error() error {
 	return c.err
 }
+
+// --------------------------------------------------------------------- //
+// StringMapConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *StringMapConverter) Result() (map[string]interface{}, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *StringMapConverter) Value() map[string]interface{} {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *StringMapConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *StringMapConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// StringStringMapConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *StringStringMapConverter) Result() (map[string]string, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *StringStringMapConverter) Value() map[string]string {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *StringStringMapConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *StringStringMapConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// StringIntMapConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *StringIntMapConverter) Result() (map[string]int64, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *StringIntMapConverter) Value() map[string]int64 {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *StringIntMapConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *StringIntMapConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// StringUintMapConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *StringUintMapConverter) Result() (map[string]uint64, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *StringUintMapConverter) Value() map[string]uint64 {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *StringUintMapConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *StringUintMapConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// StringFloatMapConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *StringFloatMapConverter) Result() (map[string]float64, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *StringFloatMapConverter) Value() map[string]float64 {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *StringFloatMapConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *StringFloatMapConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// StringBoolMapConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *StringBoolMapConverter) Result() (map[string]bool, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *StringBoolMapConverter) Value() map[string]bool {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *StringBoolMapConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *StringBoolMapConverter) Error() error {
+	return c.err
+}
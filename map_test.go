@@ -57,3 +57,57 @@ func ExampleValueConverter_Map_nested() {
 	// map[A:1 B:map[Nested2:{3} X:x Y:2]]
 	// map[A:1 B:map[Nested2:map[Z:3] X:x Y:2]]
 }
+
+func ExampleValueConverter_StringMap() {
+	in := map[interface{}]interface{}{"a": 1, "b": "2"}
+
+	fmt.Printf("%#v\n", New(in).StringMap().Value())
+	fmt.Printf("%#v\n", New(in).StringIntMap().Value())
+	fmt.Printf("%#v\n", New(in).StringStringMap().Value())
+
+	// Output:
+	// map[string]interface {}{"a":1, "b":"2"}
+	// map[string]int64{"a":1, "b":2}
+	// map[string]string{"a":"1", "b":"2"}
+}
+
+func ExampleKeyValue() {
+	type Pair struct {
+		Key   string
+		Value int
+	}
+
+	var pairs []Pair
+	if err := New(map[string]string{"a": "1"}).Convert(&pairs); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", pairs)
+	}
+
+	var m map[string]int
+	if err := New([]Pair{{Key: "a", Value: 1}, {Key: "b", Value: 2}}).Convert(&m); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", m)
+	}
+
+	m = nil
+	if err := New([][]interface{}{{"a", 1}, {"b", "2"}}).Convert(&m); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", m)
+	}
+
+	m = nil
+	if err := New([]interface{}{"a", 1, "b", "2"}).Convert(&m); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", m)
+	}
+
+	// Output:
+	// []henge.Pair{henge.Pair{Key:"a", Value:1}}
+	// map[string]int{"a":1, "b":2}
+	// map[string]int{"a":1, "b":2}
+	// map[string]int{"a":1, "b":2}
+}
@@ -0,0 +1,203 @@
+package henge
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+var (
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+	bigIntType     = reflect.TypeOf(big.Int{})
+	bigFloatType   = reflect.TypeOf(big.Float{})
+	bigRatType     = reflect.TypeOf(big.Rat{})
+)
+
+// isPreciseNumberType returns true if the type is json.Number or a type of math/big package.
+// These types are converted via big.Rat, so that the precision is not lost.
+func isPreciseNumberType(t reflect.Type) bool {
+	return t == jsonNumberType || isBigNumberType(t)
+}
+
+// isBigNumberType returns true if the type is big.Int, big.Float or big.Rat.
+func isBigNumberType(t reflect.Type) bool {
+	return t == bigIntType || t == bigFloatType || t == bigRatType
+}
+
+// rat converts the input to big.Rat without losing the precision.
+func (c *ValueConverter) rat() (*big.Rat, error) {
+	inV := reflect.Indirect(c.reflectValue)
+	if !inV.IsValid() {
+		return nil, ErrInvalidValue
+	}
+
+	switch inV.Type() {
+	case bigIntType:
+		v := inV.Interface().(big.Int)
+		return new(big.Rat).SetInt(&v), nil
+	case bigFloatType:
+		v := inV.Interface().(big.Float)
+		if v.IsInf() {
+			return nil, ErrOverflow
+		}
+		r, _ := v.Rat(nil)
+		return r, nil
+	case bigRatType:
+		v := inV.Interface().(big.Rat)
+		return new(big.Rat).Set(&v), nil
+	}
+
+	switch inV.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(inV.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Rat).SetUint64(inV.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(inV.Float()) || math.IsInf(inV.Float(), 0) {
+			return nil, ErrOverflow
+		}
+		return new(big.Rat).SetFloat64(inV.Float()), nil
+	case reflect.Bool:
+		if inV.Bool() {
+			return big.NewRat(1, 1), nil
+		}
+		return new(big.Rat), nil
+	case reflect.String:
+		if r, ok := new(big.Rat).SetString(inV.String()); ok {
+			return r, nil
+		}
+		return nil, ErrInvalidValue
+	default:
+		return nil, ErrUnsupportedType
+	}
+}
+
+// integer converts the input to big.Int.
+// If the input has a fractional part, it is rounded by the rounding function in the same way as float.
+func (c *ValueConverter) integer() (*big.Int, error) {
+	r, err := c.rat()
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: QuoRem truncates toward zero.
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return q, nil
+	}
+
+	// NOTE: The result of rounding depends only on the sign, the parity of the integer part and
+	//       whether the fractional part is less than, equal to or greater than 0.5.
+	//       Therefore, the rounding function is called with a small float that has the same properties, so that the precision is not lost.
+	var frac float64
+	switch new(big.Int).Abs(new(big.Int).Lsh(rem, 1)).Cmp(r.Denom()) {
+	case -1:
+		frac = 0.25
+	case 0:
+		frac = 0.5
+	default:
+		frac = 0.75
+	}
+	if rem.Sign() < 0 {
+		frac = -frac
+	}
+	parity := new(big.Int).Rem(q, big.NewInt(2)).Int64()
+	rounded := c.opts.numOpts.roundingFunc(float64(parity) + frac)
+	return q.Add(q, big.NewInt(int64(rounded)-parity)), nil
+}
+
+// preciseInt converts the input that is json.Number or a type of math/big package to int64.
+func (c *ValueConverter) preciseInt() (int64, error) {
+	i, err := c.integer()
+	if err != nil {
+		return 0, err
+	}
+	if !i.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return i.Int64(), nil
+}
+
+// preciseUint converts the input that is json.Number or a type of math/big package to uint64.
+func (c *ValueConverter) preciseUint() (uint64, error) {
+	i, err := c.integer()
+	if err != nil {
+		return 0, err
+	}
+	if i.Sign() < 0 {
+		return 0, ErrNegativeNumber
+	}
+	if !i.IsUint64() {
+		return 0, ErrOverflow
+	}
+	return i.Uint64(), nil
+}
+
+// preciseFloat converts the input that is json.Number or a type of math/big package to float64.
+func (c *ValueConverter) preciseFloat() (float64, error) {
+	r, err := c.rat()
+	if err != nil {
+		return 0, err
+	}
+	f, _ := r.Float64()
+	if math.IsInf(f, 0) {
+		return 0, ErrOverflow
+	}
+	return f, nil
+}
+
+// preciseValue converts the input that is json.Number or a type of math/big package to int64, uint64 or float64.
+// The integer is converted to int64 or uint64 if it is in the range, and otherwise it is converted to float64.
+func (c *ValueConverter) preciseValue() (interface{}, error) {
+	r, err := c.rat()
+	if err != nil {
+		return nil, err
+	}
+	if r.IsInt() {
+		if r.Num().IsInt64() {
+			return r.Num().Int64(), nil
+		}
+		if r.Num().IsUint64() {
+			return r.Num().Uint64(), nil
+		}
+	}
+	f, _ := r.Float64()
+	if math.IsInf(f, 0) {
+		return nil, ErrOverflow
+	}
+	return f, nil
+}
+
+// convertBigNumber converts the input to big.Int, big.Float or big.Rat and assigns it.
+func (c *ValueConverter) convertBigNumber(outV reflect.Value) error {
+	if c.isNil {
+		return nil
+	}
+
+	var (
+		value    interface{}
+		err      error
+		elemOutV = toInitializedNonPtrValue(outV)
+	)
+	switch elemOutV.Type() {
+	case bigIntType:
+		var i *big.Int
+		if i, err = c.integer(); err == nil {
+			value = i
+		}
+	case bigFloatType:
+		var r *big.Rat
+		if r, err = c.rat(); err == nil {
+			value = new(big.Float).SetRat(r)
+		}
+	case bigRatType:
+		value, err = c.rat()
+	}
+	if err != nil {
+		return c.wrapConvertError(c.value, outV.Type(), err)
+	}
+
+	elemOutV.Set(reflect.ValueOf(value).Elem())
+	return nil
+}
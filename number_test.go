@@ -0,0 +1,33 @@
+package henge
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+func Example_jsonNumber() {
+	fmt.Println(New(json.Number("9007199254740993")).Int().Value())
+	fmt.Println(New(json.Number("1e3")).Uint().Value())
+
+	var i big.Int
+	_ = New(json.Number("123456789012345678901234567890")).Convert(&i)
+	fmt.Println(i.String())
+
+	type Payload struct {
+		ID    int64
+		Items []string
+	}
+	var out struct {
+		Payload Payload
+	}
+	in := map[string]interface{}{"Payload": json.RawMessage(`{"ID": 9007199254740993, "Items": ["a"]}`)}
+	_ = New(in).Convert(&out)
+	fmt.Printf("%+v\n", out)
+
+	// Output:
+	// 9007199254740993
+	// 1000
+	// 123456789012345678901234567890
+	// {Payload:{ID:9007199254740993 Items:[a]}}
+}
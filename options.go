@@ -1,8 +1,12 @@
 package henge
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"math"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -18,6 +22,32 @@ type (
 	// If you want to do your own conversion, it returns the converted value and true.
 	// If you want to use default conversion, it returns nil and false.
 	StructConversionFunc func(value interface{}) (out interface{}, ok bool)
+	// BytesEncodeFunc is a function that encodes a byte slice to string.
+	// e.g. hex.EncodeToString
+	BytesEncodeFunc func([]byte) string
+	// BytesDecodeFunc is a function that decodes a string to byte slice.
+	// e.g. hex.DecodeString
+	BytesDecodeFunc func(string) ([]byte, error)
+	// MapKeyParseFunc is a function that parses a string key to the key of the map.
+	// e.g. "1,2" -> Point{X: 1, Y: 2}
+	MapKeyParseFunc func(key string) (interface{}, error)
+	// MapKeyFormatFunc is a function that formats the key of the map to a string key.
+	// e.g. Point{X: 1, Y: 2} -> "1,2"
+	MapKeyFormatFunc func(key interface{}) (string, error)
+	// ArrayLengthPolicy is a policy when the length of the input is different from the length of the output array.
+	ArrayLengthPolicy int
+)
+
+const (
+	// ArrayLengthTruncateOrPad truncates the extra elements, and pads the missing elements with zero values.
+	// It is used by default.
+	ArrayLengthTruncateOrPad ArrayLengthPolicy = iota
+	// ArrayLengthStrict returns ErrLengthMismatch if the lengths are different.
+	ArrayLengthStrict
+	// ArrayLengthTruncate truncates the extra elements, and returns ErrLengthMismatch if the input is shorter.
+	ArrayLengthTruncate
+	// ArrayLengthPad pads the missing elements with zero values, and returns ErrLengthMismatch if the input is longer.
+	ArrayLengthPad
 )
 
 var (
@@ -37,8 +67,12 @@ type (
 	converterOpts struct {
 		numOpts
 		stringOpts
+		bytesOpts
 		sliceOpts
 		mapOpts
+		structOpts
+		interfaceOpts
+		copyOpts
 	}
 	numOpts struct {
 		roundingFunc RoundingFunc
@@ -47,8 +81,20 @@ type (
 		fmt  byte
 		prec int
 	}
+	bytesOpts struct {
+		encodeFunc BytesEncodeFunc
+		decodeFunc BytesDecodeFunc
+	}
 	sliceOpts struct {
 		valueConversionFunc ConversionFunc
+		separator           string
+		trimSpace           bool
+		withoutEmptyItem    bool
+		wrapScalar          bool
+		unwrapSingleton     bool
+		mapKeyField         string
+		mapKeyLastWins      bool
+		arrayLengthPolicy   ArrayLengthPolicy
 	}
 	mapOpts struct {
 		maxDepth                  uint
@@ -57,12 +103,37 @@ type (
 		keyConversionFunc         ConversionFunc
 		valueConversionFunc       ConversionFunc
 		structValueConversionFunc StructConversionFunc
+		sortKeys                  bool
+		yaml                      bool
+		keyParseFuncs             map[reflect.Type]MapKeyParseFunc
+		keyFormatFuncs            map[reflect.Type]MapKeyFormatFunc
+	}
+	structOpts struct {
+		filterFuns    structFilterFuns
+		includeFields []string
+		excludeFields []string
+		groups        []string
+		getterMethods bool
+		setterMethods bool
+		// NOTE: If it is nil, unexported fields are copied only when the types are simply converted.
+		unexportedFields *bool
 	}
-	mapFilterFuns []func(k interface{}, v interface{}) bool
+	interfaceOpts struct {
+		implementations map[reflect.Type]implementations
+		discriminators  []implementations
+		normalize       bool
+	}
+	copyOpts struct {
+		deepCopy bool
+		merge    bool
+	}
+	mapFilterFuns    []func(k interface{}, v interface{}) bool
+	structFilterFuns []func(path string, v interface{}, field reflect.StructField) bool
 )
 
 var (
 	interfaceType = reflect.ValueOf([]interface{}{}).Type().Elem()
+	errorType     = reflect.ValueOf([]error{}).Type().Elem()
 )
 
 func (fs mapFilterFuns) All(k interface{}, v interface{}) bool {
@@ -74,6 +145,105 @@ func (fs mapFilterFuns) All(k interface{}, v interface{}) bool {
 	return true
 }
 
+func (fs structFilterFuns) All(path string, v interface{}, field reflect.StructField) bool {
+	for _, f := range fs {
+		if !f(path, v, field) {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *bytesOpts) encode(b []byte) string {
+	if o.encodeFunc == nil {
+		return string(b)
+	}
+	return o.encodeFunc(b)
+}
+
+func (o *bytesOpts) decode(s string) ([]byte, error) {
+	if o.decodeFunc == nil {
+		return []byte(s), nil
+	}
+	return o.decodeFunc(s)
+}
+
+// hasFilter returns true if any of the options to filter the fields is specified.
+func (o *structOpts) hasFilter() bool {
+	return len(o.filterFuns) > 0 || len(o.includeFields) > 0 || len(o.excludeFields) > 0 || len(o.groups) > 0
+}
+
+// isCopyable returns true if the field of the path should be copied.
+func (o *structOpts) isCopyable(path string, v interface{}, field reflect.StructField) bool {
+	return o.isCopyablePath(path) && o.filterFuns.All(path, v, field)
+}
+
+// isCopyablePath returns true if the path is not filtered by WithIncludeFields and WithExcludeFields.
+func (o *structOpts) isCopyablePath(path string) bool {
+	if len(o.includeFields) > 0 {
+		included := false
+		for _, p := range o.includeFields {
+			// NOTE: The ancestors of the included fields are also included, to reach them.
+			if isFieldPathPrefix(p, path) || isFieldPathPrefix(path, p) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	for _, p := range o.excludeFields {
+		if isFieldPathPrefix(p, path) {
+			return false
+		}
+	}
+	return true
+}
+
+// isFieldPathPrefix returns true if the path is the prefix or the same as the other path.
+// (e.g. "A" is the prefix of "A.B", but not of "AB")
+func isFieldPathPrefix(prefix string, path string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+".")
+}
+
+// copiesUnexportedFields returns true if WithUnexportedFields is specified.
+func (o *structOpts) copiesUnexportedFields() bool {
+	return o.unexportedFields != nil && *o.unexportedFields
+}
+
+// skipsUnexportedFields returns true if WithoutUnexportedFields is specified.
+func (o *structOpts) skipsUnexportedFields() bool {
+	return o.unexportedFields != nil && !*o.unexportedFields
+}
+
+// checkArrayLength returns ErrLengthMismatch if the length of the input is not allowed by the policy.
+func (o *sliceOpts) checkArrayLength(inLen int, outLen int) error {
+	switch {
+	case inLen > outLen && (o.arrayLengthPolicy == ArrayLengthStrict || o.arrayLengthPolicy == ArrayLengthPad):
+		return ErrLengthMismatch
+	case inLen < outLen && (o.arrayLengthPolicy == ArrayLengthStrict || o.arrayLengthPolicy == ArrayLengthTruncate):
+		return ErrLengthMismatch
+	default:
+		return nil
+	}
+}
+
+func (o *sliceOpts) split(s string) []string {
+	items := strings.Split(s, o.separator)
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if o.trimSpace {
+			item = strings.TrimSpace(item)
+		}
+		if o.withoutEmptyItem && item == "" {
+			continue
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
 func defaultConverterOpts() *converterOpts {
 	return &converterOpts{
 		numOpts: numOpts{
@@ -115,6 +285,31 @@ func WithRoundingFunc(f RoundingFunc) ConverterOption {
 	}
 }
 
+// WithBytesEncoding is an option when converting between byte slice and string.
+//
+// By default, it converts as is (e.g. string(b) and []byte(s)).
+// It also applies to byte slices in JSONValue, JSONArray and JSONObject.
+func WithBytesEncoding(encode BytesEncodeFunc, decode BytesDecodeFunc) ConverterOption {
+	return func(opt *converterOpts) {
+		opt.bytesOpts.encodeFunc = encode
+		opt.bytesOpts.decodeFunc = decode
+	}
+}
+
+// WithBase64Bytes is an option when converting between byte slice and string.
+//
+// It uses base64 encoding with the specified encoding. (e.g. base64.StdEncoding)
+func WithBase64Bytes(enc *base64.Encoding) ConverterOption {
+	return WithBytesEncoding(enc.EncodeToString, enc.DecodeString)
+}
+
+// WithHexBytes is an option when converting between byte slice and string.
+//
+// It uses hexadecimal encoding.
+func WithHexBytes() ConverterOption {
+	return WithBytesEncoding(hex.EncodeToString, hex.DecodeString)
+}
+
 // WithSliceValueConverter is an option when converting to slice.
 //
 // It can be used when converting values to other types.
@@ -124,6 +319,158 @@ func WithSliceValueConverter(f ConversionFunc) ConverterOption {
 	}
 }
 
+// WithSliceSeparator is an option when converting between string and slice.
+//
+// When it used, a string is split by the separator when converting to slice,
+// and a slice is joined with the separator when converting to string.
+// By default, a string cannot be converted to slice.
+func WithSliceSeparator(sep string) ConverterOption {
+	return func(opt *converterOpts) {
+		opt.sliceOpts.separator = sep
+	}
+}
+
+// WithSliceItemTrimSpace is an option when converting from string to slice.
+//
+// When it used, leading and trailing white spaces of the split items are removed.
+// It is used together with WithSliceSeparator.
+func WithSliceItemTrimSpace() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.sliceOpts.trimSpace = true
+	}
+}
+
+// WithoutEmptySliceItem is an option when converting from string to slice.
+//
+// When it used, it will not copy if the split item is empty.
+// It is used together with WithSliceSeparator.
+func WithoutEmptySliceItem() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.sliceOpts.withoutEmptyItem = true
+	}
+}
+
+// WithWrapScalar is an option when converting to slice (or array).
+//
+// When it used, a non-slice value is converted to a single-element slice. (e.g. "x" -> []string{"x"})
+// If WithSliceSeparator is specified, a string is split instead, and a map is always converted to a slice of KeyValue.
+func WithWrapScalar() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.sliceOpts.wrapScalar = true
+	}
+}
+
+// WithUnwrapSingleton is an option when converting from slice (or array) to a non-slice type.
+//
+// When it used, a single-element slice is converted using the element. (e.g. []string{"x"} -> "x")
+// If the slice has multiple elements, it returns ErrMultipleElements.
+// If the slice is empty, the output is set to the zero value. (A nil slice leaves the output untouched)
+// It does not apply when converting to map, because a slice is converted from key-value pairs.
+func WithUnwrapSingleton() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.sliceOpts.unwrapSingleton = true
+	}
+}
+
+// WithArrayLengthPolicy is an option when converting to array.
+//
+// It specifies the behavior when the length of the input is different from the length of the array.
+// By default, it uses ArrayLengthTruncateOrPad.
+func WithArrayLengthPolicy(policy ArrayLengthPolicy) ConverterOption {
+	return func(opt *converterOpts) {
+		opt.sliceOpts.arrayLengthPolicy = policy
+	}
+}
+
+// WithSliceToMapKey is an option when converting between slice of structs and map.
+//
+// When it used, a slice of structs is converted to a map indexed by the field of each struct,
+// and a map is converted to a slice of the values sorted by the keys.
+// Instead of this option, the key field can also be specified with `henge:"key"` tag.
+// If the same key appears more than once, it returns ErrDuplicateKey.
+// It does not apply to slices whose elements do not have the field, such as key-value pairs.
+func WithSliceToMapKey(field string) ConverterOption {
+	return func(opt *converterOpts) {
+		opt.sliceOpts.mapKeyField = field
+	}
+}
+
+// WithSliceToMapLastWins is an option when converting from slice of structs to map.
+//
+// When it used, the last element wins if the same key appears more than once.
+// It is used together with WithSliceToMapKey or `henge:"key"` tag.
+func WithSliceToMapLastWins() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.sliceOpts.mapKeyLastWins = true
+	}
+}
+
+// WithDeepCopy is an option when converting to any type.
+//
+// When it used, pointers, slices, maps and arrays are not shared between the input and the output.
+// The references shared in the input are also shared in the output, because the whole conversion uses the same copy context.
+// Unexported fields are copied shallowly, so the values they refer to are still shared.
+// Refer: Clone
+func WithDeepCopy() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.copyOpts.deepCopy = true
+	}
+}
+
+// WithImplementations is an option when converting to interface.
+//
+// It registers the concrete types of the interface, that are selected by the discriminator field of the input map.
+// (e.g. {"type": "circle", ...} is converted to Circle, when the discriminator is "type" and the types has "circle")
+// The type can also be a pointer type (e.g. *Circle), if the methods have pointer receivers.
+// If the discriminator field is missing or unknown, it returns ErrNotConvertible.
+func WithImplementations(ifaceType reflect.Type, discriminator string, types map[string]reflect.Type) ConverterOption {
+	return func(opt *converterOpts) {
+		if opt.interfaceOpts.implementations == nil {
+			opt.interfaceOpts.implementations = map[reflect.Type]implementations{}
+		}
+		opt.interfaceOpts.implementations[ifaceType] = implementations{discriminator: discriminator, types: types}
+	}
+}
+
+// WithDiscriminator is an option when converting to interface, and converting struct to map.
+//
+// It registers the concrete types selected by the discriminator field of the input map, for any interface.
+// (e.g. {"kind": "created", ...} is converted to Created, when the field is "kind" and the types has "created")
+// The selected type is used only if it implements the interface of the output, and otherwise the input is converted as usual.
+// It does not apply to interface{}, so that the maps in untyped data (e.g. map[string]interface{}) are kept as maps.
+// When the registered struct is converted to map, the discriminator field is added to the map.
+// Unlike WithImplementations, it can be used multiple times with different fields.
+func WithDiscriminator(field string, types map[string]reflect.Type) ConverterOption {
+	return func(opt *converterOpts) {
+		opt.interfaceOpts.discriminators = append(opt.interfaceOpts.discriminators, implementations{discriminator: field, types: types})
+	}
+}
+
+// WithNormalizedInterface is an option when converting to interface{}.
+//
+// When it used, the input is normalized recursively in the same way as JSONValue, instead of being assigned as is.
+// Therefore, maps are converted to map[string]interface{}, slices and arrays are converted to []interface{},
+// and the other values are converted to int64, uint64, float64, bool or string.
+// (e.g. map[interface{}]interface{} decoded from YAML is converted to map[string]interface{})
+// The types selected by WithImplementations and WithDiscriminator take priority over it.
+func WithNormalizedInterface() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.interfaceOpts.normalize = true
+	}
+}
+
+// WithMerge is an option when converting to struct or map.
+//
+// When it used, the output is patched with the input instead of being overwritten.
+// Nil and zero values in the input leave the output untouched, and nested maps and structs are merged recursively.
+// Slices and arrays are replaced as a whole.
+// Instead of this option, the field of the output struct can be tagged with `henge:"omitempty"`.
+func WithMerge() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.copyOpts.merge = true
+	}
+}
+
 // WithMapKeyConverter is an option when converting to map.
 //
 // It can be used when converting keys to other types.
@@ -133,6 +480,32 @@ func WithMapKeyConverter(f ConversionFunc) ConverterOption {
 	}
 }
 
+// WithMapKeyParser is an option when converting to map.
+//
+// It registers the function that parses string keys to the keys of the type. (e.g. "1,2" -> Point{X: 1, Y: 2})
+// It is used when the output map has the keys of the type, and the input map has string keys.
+func WithMapKeyParser(keyType reflect.Type, parse MapKeyParseFunc) ConverterOption {
+	return func(opt *converterOpts) {
+		if opt.mapOpts.keyParseFuncs == nil {
+			opt.mapOpts.keyParseFuncs = map[reflect.Type]MapKeyParseFunc{}
+		}
+		opt.mapOpts.keyParseFuncs[keyType] = parse
+	}
+}
+
+// WithMapKeyFormatter is an option when converting to map.
+//
+// It registers the function that formats the keys of the type to string keys. (e.g. Point{X: 1, Y: 2} -> "1,2")
+// It is used when the output map has string keys (e.g. JSONObject), and the input map has the keys of the type.
+func WithMapKeyFormatter(keyType reflect.Type, format MapKeyFormatFunc) ConverterOption {
+	return func(opt *converterOpts) {
+		if opt.mapOpts.keyFormatFuncs == nil {
+			opt.mapOpts.keyFormatFuncs = map[reflect.Type]MapKeyFormatFunc{}
+		}
+		opt.mapOpts.keyFormatFuncs[keyType] = format
+	}
+}
+
 // WithMapValueConverter is an option when converting to map.
 //
 // It can be used when converting values to other types.
@@ -177,6 +550,30 @@ func WithMapMaxDepth(maxDepth uint) ConverterOption {
 	}
 }
 
+// WithSortedMapKeys is an option when converting from map.
+//
+// When it used, maps are iterated in ascending order of the keys,
+// so the errors and the order of the outputs (e.g. map to slice) are reproducible.
+// The keys are compared as strings converted with String (e.g. 10 < 9), and the type names are compared if they are equal.
+// By default, the order of iteration is not specified.
+func WithSortedMapKeys() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.mapOpts.sortKeys = true
+	}
+}
+
+// WithYAML is an option when converting from the trees decoded from YAML (e.g. map[interface{}]interface{}).
+//
+// When it used, the keys of any type are converted to string keys with String, when the output has string keys or is a struct.
+// If different keys are converted to the same key (e.g. 1 and "1"), it returns ErrDuplicateKey instead of overwriting.
+// The maps and slices converted to interface{} are also converted to map[string]interface{} and []interface{} recursively.
+// The fields of struct are matched by the name of `yaml:"name"` tag if it exists, and the fields with `yaml:"-"` tag are ignored.
+func WithYAML() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.mapOpts.yaml = true
+	}
+}
+
 // WithMapFilter is an option when converting to map.
 //
 // If you specify multiple filters, it will be copied only if all filters return true.
@@ -223,6 +620,111 @@ func WithoutZeroMapValue() ConverterOption {
 	})
 }
 
+// WithStructFilter is an option when converting to struct.
+//
+// The filter receives the path of the field (e.g. "Address.City"), the input value and the field of the output struct,
+// and the field is copied only if it returns true.
+// The path is the field names joined with ".", and the slice indexes and the map keys are not included.
+// If you specify multiple filters, it will be copied only if all filters return true.
+// By default, it copies everything.
+func WithStructFilter(cond func(path string, v interface{}, field reflect.StructField) bool) ConverterOption {
+	return func(opt *converterOpts) {
+		opt.structOpts.filterFuns = append(opt.structOpts.filterFuns, cond)
+	}
+}
+
+// WithIncludeFields is an option when converting to struct.
+//
+// When it used, only the fields of the paths (e.g. "Address.City") and their descendants are copied.
+// Refer: WithStructFilter
+func WithIncludeFields(paths ...string) ConverterOption {
+	return func(opt *converterOpts) {
+		opt.structOpts.includeFields = append(opt.structOpts.includeFields, paths...)
+	}
+}
+
+// WithExcludeFields is an option when converting to struct.
+//
+// When it used, the fields of the paths (e.g. "Address.City") and their descendants are not copied.
+// Refer: WithStructFilter
+func WithExcludeFields(paths ...string) ConverterOption {
+	return func(opt *converterOpts) {
+		opt.structOpts.excludeFields = append(opt.structOpts.excludeFields, paths...)
+	}
+}
+
+// WithGroups is an option when converting from struct or to struct.
+//
+// When it used, the fields tagged with `henge:"group=..."` are copied only if they belong to any of the groups.
+// (e.g. `henge:"group=admin,group=internal"` is copied with WithGroups("admin"), but not with WithGroups("public"))
+// The fields without the tag are always copied, and the tags are not used if this option is not specified.
+// The names of the other tag options (e.g. "key", "omitempty") and an empty name are reserved, and they cause panic.
+func WithGroups(groups ...string) ConverterOption {
+	for _, group := range groups {
+		if isReservedGroup(group) {
+			panic("reserved group name: " + strconv.Quote(group))
+		}
+	}
+	return func(opt *converterOpts) {
+		opt.structOpts.groups = append(opt.structOpts.groups, groups...)
+	}
+}
+
+// WithGetterMethods is an option when converting from struct to struct.
+//
+// When it used, if the input has no field of the same name, the getter method is used instead.
+// (e.g. FullName() or GetFullName() for the FullName field)
+// The method must have no arguments and return a value, or a value and an error.
+func WithGetterMethods() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.structOpts.getterMethods = true
+	}
+}
+
+// WithSetterMethods is an option when converting to struct.
+//
+// When it used, if the output has no exported field of the same name, the setter method is used instead.
+// (e.g. SetName(v) for the Name field or key)
+// The method must have an argument, and the value is converted to the type of the argument.
+// If the method returns an error at last, the conversion fails with it.
+func WithSetterMethods() ConverterOption {
+	return func(opt *converterOpts) {
+		opt.structOpts.setterMethods = true
+	}
+}
+
+// WithUnexportedFields is an option when converting to struct.
+//
+// When it used, unexported fields are copied to the unexported fields of the same name, even if the types are different.
+// It reads and writes the fields using the unsafe package.
+// By default, unexported fields are copied only if the types are simply converted. (e.g. the same types)
+func WithUnexportedFields() ConverterOption {
+	return func(opt *converterOpts) {
+		copies := true
+		opt.structOpts.unexportedFields = &copies
+	}
+}
+
+// WithoutUnexportedFields is an option when converting to struct.
+//
+// When it used, unexported fields are never copied, even if the types are simply converted. (e.g. the same types)
+func WithoutUnexportedFields() ConverterOption {
+	return func(opt *converterOpts) {
+		copies := false
+		opt.structOpts.unexportedFields = &copies
+	}
+}
+
+// isReservedGroup returns true if the group name cannot be used, because it is confusing with the other tag options.
+func isReservedGroup(group string) bool {
+	switch group {
+	case "", "-", "key", "omitempty", "index", "group":
+		return true
+	default:
+		return strings.HasPrefix(group, "index=") || strings.HasPrefix(group, "group=")
+	}
+}
+
 func isNil(i interface{}) bool {
 	v := reflect.ValueOf(i)
 	switch v.Kind() {
@@ -1,9 +1,11 @@
 package henge
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
+	"strings"
 	"time"
 )
 
@@ -345,3 +347,485 @@ func ExampleWithoutZeroMapValue() {
 	// Default:                       map[a:<nil> b:<nil> c: d:d]
 	// Except when the value is zero: map[d:d]
 }
+
+func ExampleWithSliceSeparator() {
+	type Query struct {
+		IDs  string
+		Tags string
+	}
+	type Params struct {
+		IDs  []int
+		Tags []string
+	}
+
+	var params Params
+	if err := New(Query{IDs: "1,2,3", Tags: "a, b,,c"}, WithSliceSeparator(",")).Convert(&params); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", params)
+	}
+
+	var query Query
+	if err := New(params, WithSliceSeparator(",")).Convert(&query); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", query)
+	}
+
+	// Output:
+	// henge.Params{IDs:[]int{1, 2, 3}, Tags:[]string{"a", " b", "", "c"}}
+	// henge.Query{IDs:"1,2,3", Tags:"a, b,,c"}
+}
+
+func ExampleWithSliceItemTrimSpace() {
+	fmt.Printf(
+		"Default:                %#v\n",
+		New("a, b,,c", WithSliceSeparator(",")).StringSlice().Value(),
+	)
+	fmt.Printf(
+		"WithSliceItemTrimSpace: %#v\n",
+		New("a, b,,c", WithSliceSeparator(","), WithSliceItemTrimSpace()).StringSlice().Value(),
+	)
+
+	// Output:
+	// Default:                []string{"a", " b", "", "c"}
+	// WithSliceItemTrimSpace: []string{"a", "b", "", "c"}
+}
+
+func ExampleWithoutEmptySliceItem() {
+	fmt.Printf(
+		"Default:                %#v\n",
+		New("a, ,,c", WithSliceSeparator(","), WithSliceItemTrimSpace()).StringSlice().Value(),
+	)
+	fmt.Printf(
+		"WithoutEmptySliceItem:  %#v\n",
+		New("a, ,,c", WithSliceSeparator(","), WithSliceItemTrimSpace(), WithoutEmptySliceItem()).StringSlice().Value(),
+	)
+
+	// Output:
+	// Default:                []string{"a", "", "", "c"}
+	// WithoutEmptySliceItem:  []string{"a", "c"}
+}
+
+func ExampleWithWrapScalar() {
+	type Form struct {
+		Name string
+		Tags interface{}
+	}
+	type Request struct {
+		Name []string
+		Tags []string
+	}
+
+	var req Request
+	if err := New(Form{Name: "Alice", Tags: []string{"a", "b"}}, WithWrapScalar()).Convert(&req); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", req)
+	}
+
+	// Output:
+	// henge.Request{Name:[]string{"Alice"}, Tags:[]string{"a", "b"}}
+}
+
+func ExampleWithUnwrapSingleton() {
+	type Form struct {
+		Name []string
+		Age  []string
+	}
+	type Request struct {
+		Name string
+		Age  int
+	}
+
+	var req Request
+	if err := New(Form{Name: []string{"Alice"}, Age: []string{"30"}}, WithUnwrapSingleton()).Convert(&req); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", req)
+	}
+
+	if err := New(Form{Name: []string{"Alice", "Bob"}}, WithUnwrapSingleton()).Convert(&req); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", req)
+	}
+
+	// Output:
+	// henge.Request{Name:"Alice", Age:30}
+	// Failed to convert from []string to string: fields=.Name, value=[]string{"Alice", "Bob"}, error=multiple elements
+}
+
+func ExampleWithSliceToMapKey() {
+	type User struct {
+		ID   int64
+		Name string
+	}
+	users := []User{{ID: 2, Name: "Bob"}, {ID: 1, Name: "Alice"}}
+
+	var m map[int64]User
+	if err := New(users, WithSliceToMapKey("ID")).Convert(&m); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", m)
+	}
+
+	var s []User
+	if err := New(m, WithSliceToMapKey("ID")).Convert(&s); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", s)
+	}
+
+	users = append(users, User{ID: 1, Name: "Carol"})
+	if err := New(users, WithSliceToMapKey("ID")).Convert(&m); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", m)
+	}
+
+	// Output:
+	// map[int64]henge.User{1:henge.User{ID:1, Name:"Alice"}, 2:henge.User{ID:2, Name:"Bob"}}
+	// []henge.User{henge.User{ID:1, Name:"Alice"}, henge.User{ID:2, Name:"Bob"}}
+	// Failed to convert from henge.User to map[int64]henge.User: fields=[2], value=henge.User{ID:1, Name:"Carol"}, error=duplicate key
+}
+
+func ExampleWithSliceToMapLastWins() {
+	type User struct {
+		ID   int64 `henge:"key"`
+		Name string
+	}
+	users := []User{{ID: 1, Name: "Alice"}, {ID: 1, Name: "Carol"}}
+
+	var m map[string]User
+	if err := New(users, WithSliceToMapLastWins()).Convert(&m); err != nil {
+		fmt.Println(err)
+	} else {
+		fmt.Printf("%#v\n", m)
+	}
+
+	// Output:
+	// map[string]henge.User{"1":henge.User{ID:1, Name:"Carol"}}
+}
+
+func ExampleWithSortedMapKeys() {
+	in := map[string]string{"c": "3", "a": "x", "b": "y"}
+
+	var out map[string]int
+	for i := 0; i < 3; i++ {
+		fmt.Println(New(in, WithSortedMapKeys()).Convert(&out))
+	}
+
+	fmt.Println(New(map[interface{}]int{"b": 2, 10: 10, "a": 1, 2: 2}, WithSortedMapKeys()).Slice().Value())
+
+	// Output:
+	// Failed to convert from string to int: fields=[a], value="x", error=strconv.ParseInt: parsing "x": invalid syntax
+	// Failed to convert from string to int: fields=[a], value="x", error=strconv.ParseInt: parsing "x": invalid syntax
+	// Failed to convert from string to int: fields=[a], value="x", error=strconv.ParseInt: parsing "x": invalid syntax
+	// [{10 10} {2 2} {a 1} {b 2}]
+}
+
+func ExampleWithDeepCopy() {
+	type Value struct {
+		Tags []string
+	}
+
+	in := Value{Tags: []string{"a"}}
+
+	var shallow Value
+	_ = New(in).Convert(&shallow)
+	shallow.Tags[0] = "b"
+	fmt.Printf("Default:      %v\n", in.Tags)
+
+	in.Tags[0] = "a"
+	var deep Value
+	_ = New(in, WithDeepCopy()).Convert(&deep)
+	deep.Tags[0] = "b"
+	fmt.Printf("WithDeepCopy: %v\n", in.Tags)
+
+	// Output:
+	// Default:      [b]
+	// WithDeepCopy: [a]
+}
+
+func ExampleWithMerge() {
+	type Profile struct {
+		Name string
+		Age  *int
+		Tags map[string]interface{}
+	}
+
+	age := 20
+	dst := Profile{Name: "Alice", Age: &age, Tags: map[string]interface{}{"a": 1}}
+	patch := map[string]interface{}{"Age": nil, "Tags": map[string]interface{}{"b": 2}}
+	_ = New(patch, WithMerge()).Convert(&dst)
+	fmt.Printf("%v %v %v\n", dst.Name, *dst.Age, dst.Tags)
+
+	// Output:
+	// Alice 20 map[a:1 b:2]
+}
+
+func ExampleWithStructFilter() {
+	type User struct {
+		Name     string
+		Password string
+	}
+
+	var out User
+	_ = New(User{Name: "Alice", Password: "secret"}, WithStructFilter(func(path string, v interface{}, field reflect.StructField) bool {
+		return field.Name != "Password"
+	})).Convert(&out)
+	fmt.Printf("%#v\n", out)
+
+	// Output:
+	// henge.User{Name:"Alice", Password:""}
+}
+
+func ExampleWithIncludeFields() {
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type User struct {
+		Name    string
+		Address Address
+	}
+
+	var out User
+	_ = New(User{Name: "Alice", Address: Address{City: "Tokyo", Zip: "100"}}, WithIncludeFields("Address.City")).Convert(&out)
+	fmt.Printf("%#v\n", out)
+
+	// Output:
+	// henge.User{Name:"", Address:henge.Address{City:"Tokyo", Zip:""}}
+}
+
+func ExampleWithExcludeFields() {
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type User struct {
+		Name    string
+		Address Address
+	}
+
+	var out User
+	_ = New(User{Name: "Alice", Address: Address{City: "Tokyo", Zip: "100"}}, WithExcludeFields("Address.Zip")).Convert(&out)
+	fmt.Printf("%#v\n", out)
+
+	// Output:
+	// henge.User{Name:"Alice", Address:henge.Address{City:"Tokyo", Zip:""}}
+}
+
+func ExampleWithGroups() {
+	type User struct {
+		Name  string
+		Email string `henge:"group=admin,group=internal"`
+	}
+
+	in := User{Name: "Alice", Email: "alice@example.com"}
+
+	var public, admin User
+	_ = New(in, WithGroups("public")).Convert(&public)
+	_ = New(in, WithGroups("admin")).Convert(&admin)
+	fmt.Printf("%#v\n", public)
+	fmt.Printf("%#v\n", admin)
+
+	// Output:
+	// henge.User{Name:"Alice", Email:""}
+	// henge.User{Name:"Alice", Email:"alice@example.com"}
+}
+
+type getterExample struct {
+	FirstName string
+	LastName  string
+}
+
+func (e getterExample) GetFullName() string {
+	return e.FirstName + " " + e.LastName
+}
+
+func ExampleWithGetterMethods() {
+	type Out struct {
+		FullName string
+	}
+
+	var out Out
+	_ = New(getterExample{FirstName: "Alice", LastName: "Smith"}, WithGetterMethods()).Convert(&out)
+	fmt.Println(out.FullName)
+
+	// Output:
+	// Alice Smith
+}
+
+type setterExample struct {
+	name string
+}
+
+func (e *setterExample) SetName(name string) {
+	e.name = name
+}
+
+func ExampleWithSetterMethods() {
+	var out setterExample
+	_ = New(map[string]interface{}{"Name": "Alice"}, WithSetterMethods()).Convert(&out)
+	fmt.Println(out.name)
+
+	// Output:
+	// Alice
+}
+
+func ExampleWithUnexportedFields() {
+	type In struct {
+		id int
+	}
+	type Out struct {
+		id int64
+	}
+
+	var out1, out2 Out
+	_ = New(In{id: 1}).Convert(&out1)
+	_ = New(In{id: 1}, WithUnexportedFields()).Convert(&out2)
+	fmt.Printf("Default:              %v\n", out1.id)
+	fmt.Printf("WithUnexportedFields: %v\n", out2.id)
+
+	// Output:
+	// Default:              0
+	// WithUnexportedFields: 1
+}
+
+func ExampleWithoutUnexportedFields() {
+	type T struct {
+		Name string
+		id   int
+	}
+
+	var out1, out2 T
+	_ = New(T{Name: "a", id: 1}).Convert(&out1)
+	_ = New(T{Name: "a", id: 1}, WithoutUnexportedFields()).Convert(&out2)
+	fmt.Printf("Default:                 %+v\n", out1)
+	fmt.Printf("WithoutUnexportedFields: %+v\n", out2)
+
+	// Output:
+	// Default:                 {Name:a id:1}
+	// WithoutUnexportedFields: {Name:a id:0}
+}
+
+func ExampleWithArrayLengthPolicy() {
+	var out [3]float64
+	fmt.Println(New([]interface{}{1, 2}).Convert(&out), out)
+	fmt.Println(New([]interface{}{1, 2}, WithArrayLengthPolicy(ArrayLengthStrict)).Convert(&out))
+
+	// Output:
+	// <nil> [1 2 0]
+	// Failed to convert from []interface {} to [3]float64: fields=, value=[]interface {}{1, 2}, error=length mismatch
+}
+
+type pointKey struct {
+	X, Y int
+}
+
+func ExampleWithMapKeyParser() {
+	parse := func(key string) (interface{}, error) {
+		return strings.Split(key, ","), nil
+	}
+
+	var out map[pointKey]string
+	_ = New(map[string]string{"1,2": "a"}, WithMapKeyParser(reflect.TypeOf(pointKey{}), parse)).Convert(&out)
+	fmt.Printf("%v\n", out)
+
+	// Output:
+	// map[{1 2}:a]
+}
+
+func ExampleWithMapKeyFormatter() {
+	format := func(key interface{}) (string, error) {
+		p := key.(pointKey)
+		return fmt.Sprintf("%d,%d", p.X, p.Y), nil
+	}
+
+	in := map[pointKey]string{{X: 1, Y: 2}: "a"}
+	fmt.Printf("%v\n", New(in, WithMapKeyFormatter(reflect.TypeOf(pointKey{}), format)).JSONObject().Value())
+
+	// Output:
+	// map[1,2:a]
+}
+
+type exampleShape interface {
+	Area() float64
+}
+
+type exampleSquare struct {
+	Size float64
+}
+
+func (s exampleSquare) Area() float64 {
+	return s.Size * s.Size
+}
+
+func ExampleWithImplementations() {
+	types := map[string]reflect.Type{"square": reflect.TypeOf(exampleSquare{})}
+
+	var out exampleShape
+	in := map[string]interface{}{"type": "square", "Size": 2}
+	_ = New(in, WithImplementations(reflect.TypeOf((*exampleShape)(nil)).Elem(), "type", types)).Convert(&out)
+	fmt.Printf("%#v %v\n", out, out.Area())
+
+	// Output:
+	// henge.exampleSquare{Size:2} 4
+}
+
+func ExampleWithDiscriminator() {
+	opt := WithDiscriminator("kind", map[string]reflect.Type{"square": reflect.TypeOf(exampleSquare{})})
+
+	var out []exampleShape
+	in := []map[string]interface{}{{"kind": "square", "Size": 2}}
+	_ = New(in, opt).Convert(&out)
+	fmt.Printf("%#v\n", out)
+
+	m, _ := New(out[0], opt).JSONObject().Result()
+	fmt.Println(m)
+
+	// Output:
+	// []henge.exampleShape{henge.exampleSquare{Size:2}}
+	// map[Size:2 kind:square]
+}
+
+func ExampleWithNormalizedInterface() {
+	in := map[interface{}]interface{}{
+		"a": map[interface{}]interface{}{1: []int{1, 2}},
+	}
+
+	var out interface{}
+	_ = New(in, WithNormalizedInterface()).Convert(&out)
+	fmt.Printf("%#v\n", out)
+
+	// Output:
+	// map[string]interface {}{"a":map[string]interface {}{"1":[]interface {}{1, 2}}}
+}
+
+func ExampleWithYAML() {
+	type Config struct {
+		Name    string            `yaml:"name"`
+		Codes   map[string]string `yaml:"codes"`
+		Options interface{}       `yaml:"options"`
+	}
+
+	in := map[interface{}]interface{}{
+		"name":    "a",
+		"codes":   map[interface{}]interface{}{200: "OK"},
+		"options": map[interface{}]interface{}{true: []interface{}{1}},
+	}
+
+	var out Config
+	_ = New(in, WithYAML()).Convert(&out)
+	fmt.Printf("%#v\n", out)
+
+	in["codes"] = map[interface{}]interface{}{200: "OK", "200": "NG"}
+	err := New(in, WithYAML()).Convert(&out)
+	fmt.Println(errors.Is(err, ErrDuplicateKey))
+
+	// Output:
+	// henge.Config{Name:"a", Codes:map[string]string{"200":"OK"}, Options:map[string]interface {}{"true":[]interface {}{1}}}
+	// true
+}
@@ -0,0 +1,241 @@
+package henge
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// JSONSchemaDialect is the dialect of JSON Schema generated by JSONSchema.
+	JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+)
+
+type (
+	schemaGenerator struct {
+		opts *converterOpts
+		defs map[string]interface{}
+		refs map[reflect.Type]string
+	}
+)
+
+// JSONSchema returns the JSON Schema that describes the input accepted when converting to the type with the options.
+//
+// The schema follows the conversion rules of henge, so that a number is also accepted as a string and so on.
+// Fields with `henge:"-"` tag, invisible fields by WithGroups and fields excluded by WithIncludeFields or WithExcludeFields are omitted.
+// WithStructFilter is not reflected, because it depends on the input value.
+// The named struct types are defined in "$defs" and referenced by "$ref", so that the recursive types are supported.
+// Therefore, WithIncludeFields and WithExcludeFields are applied to the fields of the named struct type at the first path it appears.
+func JSONSchema(t reflect.Type, fs ...ConverterOption) map[string]interface{} {
+	opts := defaultConverterOpts()
+	for _, f := range fs {
+		f(opts)
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	g := &schemaGenerator{opts: opts, defs: map[string]interface{}{}, refs: map[reflect.Type]string{t: "#"}}
+
+	rootSchema := g.schemaOf(t, "")
+	if t.Kind() == reflect.Struct {
+		rootSchema = g.structSchemaOf(t, "")
+	}
+
+	schema := map[string]interface{}{"$schema": JSONSchemaDialect}
+	for k, v := range rootSchema {
+		schema[k] = v
+	}
+	if len(g.defs) > 0 {
+		schema["$defs"] = g.defs
+	}
+	return schema
+}
+
+// schemaOf returns the schema of the type.
+// The path is the field path used by WithIncludeFields and WithExcludeFields.
+func (g *schemaGenerator) schemaOf(t reflect.Type, path string) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case jsonRawMessageType:
+		return map[string]interface{}{}
+	case jsonNumberType, bigIntType, bigFloatType, bigRatType:
+		return map[string]interface{}{"type": []string{"number", "string", "boolean"}}
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits := uint(t.Bits())
+		return map[string]interface{}{
+			"type":    []string{"number", "string", "boolean"},
+			"minimum": int64(-1) << (bits - 1),
+			"maximum": int64(math.MaxInt64) >> (64 - bits),
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		bits := uint(t.Bits())
+		return map[string]interface{}{
+			"type":    []string{"number", "string", "boolean"},
+			"minimum": 0,
+			"maximum": uint64(math.MaxUint64) >> (64 - bits),
+		}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": []string{"number", "string", "boolean"}}
+	case reflect.Bool:
+		return map[string]interface{}{"type": []string{"boolean", "number", "string"}}
+	case reflect.String:
+		if g.opts.sliceOpts.separator != "" {
+			return map[string]interface{}{"type": []string{"string", "number", "boolean", "array"}}
+		}
+		return map[string]interface{}{"type": []string{"string", "number", "boolean"}}
+	case reflect.Array, reflect.Slice:
+		return g.arraySchemaOf(t, path)
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": g.schemaOf(t.Elem(), path),
+		}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchemaOf(t, path)
+		}
+		return map[string]interface{}{"$ref": g.refOf(t, path)}
+	case reflect.Interface:
+		return g.interfaceSchemaOf(t, path)
+	default:
+		// NOTE: It cannot be converted from JSON.
+		return map[string]interface{}{"not": map[string]interface{}{}}
+	}
+}
+
+// arraySchemaOf returns the schema of the slice or array type.
+func (g *schemaGenerator) arraySchemaOf(t reflect.Type, path string) map[string]interface{} {
+	items := g.schemaOf(t.Elem(), path)
+	schema := map[string]interface{}{"type": "array", "items": items}
+	if isBytesType(t) {
+		schema["type"] = []string{"string", "array"}
+	}
+	if t.Kind() == reflect.Array {
+		switch g.opts.sliceOpts.arrayLengthPolicy {
+		case ArrayLengthStrict:
+			schema["minItems"], schema["maxItems"] = t.Len(), t.Len()
+		case ArrayLengthTruncate:
+			schema["minItems"] = t.Len()
+		case ArrayLengthPad:
+			schema["maxItems"] = t.Len()
+		}
+	}
+
+	alternatives := []interface{}{schema}
+	if g.opts.sliceOpts.separator != "" && !isBytesType(t) {
+		alternatives = append(alternatives, map[string]interface{}{"type": "string"})
+	}
+	if g.opts.sliceOpts.wrapScalar {
+		alternatives = append(alternatives, items)
+	}
+	if len(alternatives) == 1 {
+		return schema
+	}
+	return map[string]interface{}{"anyOf": alternatives}
+}
+
+// structSchemaOf returns the schema of the struct type.
+// The struct tagged with `henge:"index=N"` is also accepted as an array.
+func (g *schemaGenerator) structSchemaOf(t reflect.Type, path string) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for _, field := range getStructFields(t) {
+		if field.isIgnore() || !field.isVisible(g.opts.structOpts.groups) {
+			continue
+		}
+		if _, ok := properties[field.name]; ok {
+			// NOTE: the higher-level field takes precedence.
+			continue
+		}
+		if t.FieldByIndex(field.index).PkgPath != "" && !g.opts.structOpts.copiesUnexportedFields() {
+			continue
+		}
+		fieldPath := fieldPath(path + "." + field.name)
+		if !g.opts.structOpts.isCopyablePath(fieldPath) {
+			continue
+		}
+		properties[field.name] = g.schemaOf(t.FieldByIndex(field.index).Type, fieldPath)
+	}
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+
+	tupleFields, err := getStructTupleFields(t)
+	if err != nil || len(tupleFields) == 0 || !tupleFields[0].tags[0].hasIndex {
+		return schema
+	}
+	prefixItems := make([]interface{}, tupleLength(tupleFields))
+	for i := range prefixItems {
+		// NOTE: The position without the field is ignored.
+		prefixItems[i] = map[string]interface{}{}
+	}
+	for _, field := range tupleFields {
+		prefixItems[field.position] = g.schemaOf(t.FieldByIndex(field.index).Type, fieldPath(path+"."+field.name))
+	}
+	return map[string]interface{}{
+		"anyOf": []interface{}{schema, map[string]interface{}{"type": "array", "prefixItems": prefixItems}},
+	}
+}
+
+// interfaceSchemaOf returns the schema of the interface type.
+// The concrete types registered by WithImplementations or WithDiscriminator are listed in "oneOf".
+func (g *schemaGenerator) interfaceSchemaOf(t reflect.Type, path string) map[string]interface{} {
+	var list []implementations
+	if impls, ok := g.opts.interfaceOpts.implementations[t]; ok {
+		list = append(list, impls)
+	} else if t.NumMethod() > 0 {
+		list = g.opts.interfaceOpts.discriminators
+	}
+
+	var oneOf []interface{}
+	for _, impls := range list {
+		names := make([]string, 0, len(impls.types))
+		for name, implType := range impls.types {
+			if implType.Implements(t) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			oneOf = append(oneOf, map[string]interface{}{
+				"allOf": []interface{}{
+					g.schemaOf(impls.types[name], path),
+					map[string]interface{}{
+						"type":       "object",
+						"properties": map[string]interface{}{impls.discriminator: map[string]interface{}{"const": name}},
+						"required":   []string{impls.discriminator},
+					},
+				},
+			})
+		}
+	}
+	if len(oneOf) == 0 {
+		return map[string]interface{}{}
+	}
+	return map[string]interface{}{"oneOf": oneOf}
+}
+
+// refOf returns the reference to the definition of the named struct type.
+func (g *schemaGenerator) refOf(t reflect.Type, path string) string {
+	if ref, ok := g.refs[t]; ok {
+		return ref
+	}
+
+	name := t.String()
+	for i := 2; g.defs[name] != nil; i++ {
+		name = t.String() + "_" + strconv.Itoa(i)
+	}
+	ref := "#/$defs/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+	g.refs[t] = ref
+	// NOTE: It reserves the name before generating, because the type may be recursive.
+	g.defs[name] = map[string]interface{}{}
+	g.defs[name] = g.structSchemaOf(t, path)
+	return ref
+}
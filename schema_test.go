@@ -0,0 +1,53 @@
+package henge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+func ExampleJSONSchema() {
+	type User struct {
+		Name     string
+		Age      uint8
+		Password string `henge:"-"`
+		Tags     []string
+	}
+
+	b, _ := json.MarshalIndent(JSONSchema(reflect.TypeOf(User{})), "", "  ")
+	fmt.Println(string(b))
+
+	// Output:
+	// {
+	//   "$schema": "https://json-schema.org/draft/2020-12/schema",
+	//   "properties": {
+	//     "Age": {
+	//       "maximum": 255,
+	//       "minimum": 0,
+	//       "type": [
+	//         "number",
+	//         "string",
+	//         "boolean"
+	//       ]
+	//     },
+	//     "Name": {
+	//       "type": [
+	//         "string",
+	//         "number",
+	//         "boolean"
+	//       ]
+	//     },
+	//     "Tags": {
+	//       "items": {
+	//         "type": [
+	//           "string",
+	//           "number",
+	//           "boolean"
+	//         ]
+	//       },
+	//       "type": "array"
+	//     }
+	//   },
+	//   "type": "object"
+	// }
+}
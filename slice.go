@@ -37,6 +37,62 @@ type (
 		value []string
 		err   error
 	}
+
+	// BoolSliceConverter is a converter that converts a slice of bool type to another type.
+	BoolSliceConverter struct {
+		*baseConverter
+		value []bool
+		err   error
+	}
+
+	// IntegerPtrSliceConverter is a converter that converts a slice of pointer of integer type to another type.
+	IntegerPtrSliceConverter struct {
+		*baseConverter
+		value []*int64
+		err   error
+	}
+
+	// UnsignedIntegerPtrSliceConverter is a converter that converts a slice of pointer of uint type to another type.
+	UnsignedIntegerPtrSliceConverter struct {
+		*baseConverter
+		value []*uint64
+		err   error
+	}
+
+	// FloatPtrSliceConverter is a converter that converts a slice of pointer of float type to another type.
+	FloatPtrSliceConverter struct {
+		*baseConverter
+		value []*float64
+		err   error
+	}
+
+	// StringPtrSliceConverter is a converter that converts a slice of pointer of string type to another type.
+	StringPtrSliceConverter struct {
+		*baseConverter
+		value []*string
+		err   error
+	}
+
+	// BoolPtrSliceConverter is a converter that converts a slice of pointer of bool type to another type.
+	BoolPtrSliceConverter struct {
+		*baseConverter
+		value []*bool
+		err   error
+	}
+
+	// MapSliceConverter is a converter that converts a slice of map type to another type.
+	MapSliceConverter struct {
+		*baseConverter
+		value []map[string]interface{}
+		err   error
+	}
+
+	// JSONValueSliceConverter is a converter that converts a slice of JSON value type to another type.
+	JSONValueSliceConverter struct {
+		*baseConverter
+		value []interface{}
+		err   error
+	}
 )
 
 // --------------------------------------------------------------------- //
@@ -44,6 +100,9 @@ type (
 // --------------------------------------------------------------------- //
 
 // Slice converts the input to slice type.
+//
+// A struct is converted to a tuple ordered by the fields, or by `henge:"index=N"` tags if any field has it.
+// The tuple can also be converted to the struct in the same order.
 func (c *ValueConverter) Slice() *SliceConverter {
 	var (
 		value []interface{}
@@ -51,6 +110,10 @@ func (c *ValueConverter) Slice() *SliceConverter {
 	)
 
 	inV := reflect.Indirect(c.reflectValue)
+	if inV.Kind() == reflect.String && c.opts.sliceOpts.separator != "" {
+		inV = reflect.ValueOf(c.opts.sliceOpts.split(inV.String()))
+	}
+
 	switch inV.Kind() {
 	case reflect.Array, reflect.Slice:
 		value = make([]interface{}, inV.Len())
@@ -59,7 +122,39 @@ func (c *ValueConverter) Slice() *SliceConverter {
 			if err = vConv.Error(); err != nil {
 				break
 			}
-			value[i] = vConv.Interface()
+			value[i] = c.copyValue(vConv.Interface())
+		}
+	case reflect.Map:
+		value = make([]interface{}, 0, inV.Len())
+		for _, key := range c.mapKeys(inV) {
+			strKey := New(key.Interface()).String().Value()
+			kv := KeyValue{Key: key.Interface(), Value: inV.MapIndex(key).Interface()}
+			vConv := c.opts.sliceOpts.valueConversionFunc(c.new(kv, c.field+"["+strKey+"]"))
+			if err = vConv.Error(); err != nil {
+				break
+			}
+			value = append(value, vConv.Interface())
+		}
+	case reflect.Struct:
+		// NOTE: A struct is converted to a tuple ordered by the fields.
+		var fields []tupleField
+		if fields, err = getStructTupleFields(inV.Type()); err != nil {
+			break
+		}
+		value = make([]interface{}, tupleLength(fields))
+		for _, field := range fields {
+			if !field.isVisible(c.opts.structOpts.groups) {
+				continue
+			}
+			v, ok := c.accessibleField(inV.Field(field.index[0]))
+			if !ok {
+				continue
+			}
+			vConv := c.opts.sliceOpts.valueConversionFunc(c.new(v.Interface(), c.field+"."+field.name))
+			if err = vConv.Error(); err != nil {
+				break
+			}
+			value[field.position] = c.copyValue(vConv.Interface())
 		}
 	default:
 		err = ErrUnsupportedType
@@ -95,6 +190,46 @@ func (c *ValueConverter) FloatSlice() *FloatSliceConverter {
 	return c.Slice().FloatSlice()
 }
 
+// BoolSlice converts the input to slice of bool type.
+func (c *ValueConverter) BoolSlice() *BoolSliceConverter {
+	return c.Slice().BoolSlice()
+}
+
+// IntPtrSlice converts the input to slice of int pointer type.
+func (c *ValueConverter) IntPtrSlice() *IntegerPtrSliceConverter {
+	return c.Slice().IntPtrSlice()
+}
+
+// UintPtrSlice converts the input to slice of uint pointer type.
+func (c *ValueConverter) UintPtrSlice() *UnsignedIntegerPtrSliceConverter {
+	return c.Slice().UintPtrSlice()
+}
+
+// FloatPtrSlice converts the input to slice of float pointer type.
+func (c *ValueConverter) FloatPtrSlice() *FloatPtrSliceConverter {
+	return c.Slice().FloatPtrSlice()
+}
+
+// StringPtrSlice converts the input to slice of string pointer type.
+func (c *ValueConverter) StringPtrSlice() *StringPtrSliceConverter {
+	return c.Slice().StringPtrSlice()
+}
+
+// BoolPtrSlice converts the input to slice of bool pointer type.
+func (c *ValueConverter) BoolPtrSlice() *BoolPtrSliceConverter {
+	return c.Slice().BoolPtrSlice()
+}
+
+// MapSlice converts the input to slice of map type.
+func (c *ValueConverter) MapSlice() *MapSliceConverter {
+	return c.Slice().MapSlice()
+}
+
+// JSONValueSlice converts the input to slice of JSON value type.
+func (c *ValueConverter) JSONValueSlice() *JSONValueSliceConverter {
+	return c.Slice().JSONValueSlice()
+}
+
 // --------------------------------------------------------------------- //
 // SliceConverter
 // --------------------------------------------------------------------- //
@@ -127,6 +262,10 @@ func (c *SliceConverter) convert(outV reflect.Value) error {
 			return unsupportedTypeErr
 		}
 
+		if err := c.opts.sliceOpts.checkArrayLength(inV.Len(), elemOutV.Len()); err != nil {
+			return c.wrapConvertError(c.value, outV.Type(), err)
+		}
+
 		v := reflect.New(reflect.ArrayOf(elemOutV.Len(), elemOutV.Type().Elem())).Elem()
 		for i := 0; i < inV.Len() && i < elemOutV.Len(); i++ {
 			elem := reflect.New(elemOutV.Type().Elem()).Elem()
@@ -153,12 +292,146 @@ func (c *SliceConverter) convert(outV reflect.Value) error {
 			v.Index(i).Set(elem)
 		}
 		elemOutV.Set(v)
+	case reflect.Struct:
+		// NOTE: A tuple is converted to a struct by the order of the fields.
+		//       The extra elements are ignored, and the fields without the elements are not changed.
+		fields, err := getStructTupleFields(elemOutV.Type())
+		if err != nil {
+			return c.wrapConvertError(c.value, outV.Type(), err)
+		}
+		for _, field := range fields {
+			// NOTE: The fields are sorted by the position.
+			if field.position >= len(c.value) {
+				break
+			}
+			if !field.isVisible(c.opts.structOpts.groups) {
+				continue
+			}
+			target, ok := c.accessibleField(elemOutV.Field(field.index[0]))
+			if !ok {
+				continue
+			}
+			fieldName := c.field + "[" + New(field.position).String().Value() + "]"
+			if err := c.new(c.value[field.position], fieldName).mergeInto(target); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		kvs, keyed, err := c.keyValues(elemOutV.Type())
+		if err != nil {
+			return err
+		}
+
+		v := reflect.MakeMap(elemOutV.Type())
+		for _, kv := range kvs {
+			keyV := reflect.New(elemOutV.Type().Key()).Elem()
+			valueV := reflect.New(elemOutV.Type().Elem()).Elem()
+			if err := c.new(kv.Key, kv.field).convert(keyV); err != nil {
+				return err
+			}
+			if err := c.new(kv.Value, kv.field).convert(valueV); err != nil {
+				return err
+			}
+			if keyed && !c.opts.sliceOpts.mapKeyLastWins && v.MapIndex(keyV).IsValid() {
+				return c.new(kv.Value, kv.field).wrapConvertError(kv.Value, elemOutV.Type(), ErrDuplicateKey)
+			}
+			v.SetMapIndex(keyV, valueV)
+		}
+		elemOutV.Set(v)
 	default:
 		return unsupportedTypeErr
 	}
 	return nil
 }
 
+type fieldKeyValue struct {
+	KeyValue
+	field string
+}
+
+// keyValues returns key-value pairs of the slice, and whether the keys are the key fields of the structs.
+//
+// The slice must consist of structs having the key field (WithSliceToMapKey or `henge:"key"` tag),
+// pairs (KeyValue-like structs or two-element slices) or alternating keys and values.
+func (c *SliceConverter) keyValues(outT reflect.Type) ([]fieldKeyValue, bool, error) {
+	kvs := make([]fieldKeyValue, 0, len(c.value))
+	fieldName := func(i int) string {
+		return c.field + "[" + New(i).String().Value() + "]"
+	}
+	unsupportedTypeErr := func(i int) error {
+		return c.new(c.value[i], fieldName(i)).wrapConvertError(c.value[i], outT, ErrUnsupportedType)
+	}
+
+	// NOTE: it is keyed only when the elements are structs having the key field, otherwise they are key-value pairs.
+	keyed := false
+	if len(c.value) > 0 {
+		_, keyed = c.keyFieldOf(c.value[0])
+	}
+	if keyed {
+		for i, elem := range c.value {
+			key, ok := c.keyFieldOf(elem)
+			if !ok {
+				return nil, keyed, unsupportedTypeErr(i)
+			}
+			kvs = append(kvs, fieldKeyValue{KeyValue: KeyValue{Key: key, Value: elem}, field: fieldName(i)})
+		}
+		return kvs, keyed, nil
+	}
+
+	if len(c.value) > 0 {
+		if _, ok := toKeyValue(c.value[0]); !ok {
+			for i := 0; i < len(c.value); i += 2 {
+				if i+1 >= len(c.value) {
+					return nil, keyed, unsupportedTypeErr(i)
+				}
+				kvs = append(kvs, fieldKeyValue{KeyValue: KeyValue{Key: c.value[i], Value: c.value[i+1]}, field: fieldName(i)})
+			}
+			return kvs, keyed, nil
+		}
+	}
+	for i, elem := range c.value {
+		kv, ok := toKeyValue(elem)
+		if !ok {
+			return nil, keyed, unsupportedTypeErr(i)
+		}
+		kvs = append(kvs, fieldKeyValue{KeyValue: kv, field: fieldName(i)})
+	}
+	return kvs, keyed, nil
+}
+
+// keyFieldOf returns the value of the key field, if the input is a struct having the key field.
+func (c *SliceConverter) keyFieldOf(i interface{}) (interface{}, bool) {
+	v := reflect.Indirect(reflect.ValueOf(i))
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	field, ok := getStructKeyField(v.Type(), c.opts.sliceOpts.mapKeyField)
+	if !ok {
+		return nil, false
+	}
+	if v, ok = fieldByIndex(v, field.index); !ok || !v.CanInterface() {
+		return nil, false
+	}
+	return v.Interface(), true
+}
+
+// toKeyValue returns a KeyValue if the input is a struct that has Key and Value fields, or a two-element slice.
+func toKeyValue(i interface{}) (KeyValue, bool) {
+	v := reflect.Indirect(reflect.ValueOf(i))
+	switch v.Kind() {
+	case reflect.Struct:
+		k, val := v.FieldByName("Key"), v.FieldByName("Value")
+		if k.IsValid() && val.IsValid() && k.CanInterface() && val.CanInterface() {
+			return KeyValue{Key: k.Interface(), Value: val.Interface()}, true
+		}
+	case reflect.Array, reflect.Slice:
+		if v.Len() == 2 && !isBytesType(v.Type()) {
+			return KeyValue{Key: v.Index(0).Interface(), Value: v.Index(1).Interface()}, true
+		}
+	}
+	return KeyValue{}, false
+}
+
 // IntSlice converts the input to slice of int type.
 func (c *SliceConverter) IntSlice() *IntegerSliceConverter {
 	var value []int64
@@ -195,6 +468,86 @@ func (c *SliceConverter) StringSlice() *StringSliceConverter {
 	return &StringSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
 }
 
+// BoolSlice converts the input to slice of bool type.
+func (c *SliceConverter) BoolSlice() *BoolSliceConverter {
+	var value []bool
+	if err := c.Convert(&value); err != nil {
+		return &BoolSliceConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &BoolSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// IntPtrSlice converts the input to slice of int pointer type.
+func (c *SliceConverter) IntPtrSlice() *IntegerPtrSliceConverter {
+	var value []*int64
+	if err := c.Convert(&value); err != nil {
+		return &IntegerPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &IntegerPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// UintPtrSlice converts the input to slice of uint pointer type.
+func (c *SliceConverter) UintPtrSlice() *UnsignedIntegerPtrSliceConverter {
+	var value []*uint64
+	if err := c.Convert(&value); err != nil {
+		return &UnsignedIntegerPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &UnsignedIntegerPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// FloatPtrSlice converts the input to slice of float pointer type.
+func (c *SliceConverter) FloatPtrSlice() *FloatPtrSliceConverter {
+	var value []*float64
+	if err := c.Convert(&value); err != nil {
+		return &FloatPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &FloatPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// StringPtrSlice converts the input to slice of string pointer type.
+func (c *SliceConverter) StringPtrSlice() *StringPtrSliceConverter {
+	var value []*string
+	if err := c.Convert(&value); err != nil {
+		return &StringPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &StringPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// BoolPtrSlice converts the input to slice of bool pointer type.
+func (c *SliceConverter) BoolPtrSlice() *BoolPtrSliceConverter {
+	var value []*bool
+	if err := c.Convert(&value); err != nil {
+		return &BoolPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &BoolPtrSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// MapSlice converts the input to slice of map type.
+func (c *SliceConverter) MapSlice() *MapSliceConverter {
+	var value []map[string]interface{}
+	if err := c.Convert(&value); err != nil {
+		return &MapSliceConverter{baseConverter: c.baseConverter, value: value, err: err}
+	}
+	return &MapSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
+// JSONValueSlice converts the input to slice of JSON value type.
+func (c *SliceConverter) JSONValueSlice() *JSONValueSliceConverter {
+	if c.err != nil || c.isNil {
+		return &JSONValueSliceConverter{baseConverter: c.baseConverter, value: nil, err: c.err}
+	}
+
+	value := make([]interface{}, len(c.value))
+	for i, elem := range c.value {
+		v, err := c.new(elem, c.field+"["+New(i).String().Value()+"]").JSONValue().Result()
+		if err != nil {
+			return &JSONValueSliceConverter{baseConverter: c.baseConverter, value: nil, err: err}
+		}
+		value[i] = v
+	}
+	return &JSONValueSliceConverter{baseConverter: c.baseConverter, value: value, err: nil}
+}
+
 // Result returns the conversion result and error
 func (c *SliceConverter) Result() ([]interface{}, error) {
 	return c.value, c.err
@@ -310,3 +663,195 @@ func (c *StringSliceConverter) Interface() interface{} {
 func (c *StringSliceConverter) Error() error {
 	return c.err
 }
+
+// --------------------------------------------------------------------- //
+// BoolSliceConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *BoolSliceConverter) Result() ([]bool, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *BoolSliceConverter) Value() []bool {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *BoolSliceConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *BoolSliceConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// IntegerPtrSliceConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *IntegerPtrSliceConverter) Result() ([]*int64, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *IntegerPtrSliceConverter) Value() []*int64 {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *IntegerPtrSliceConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *IntegerPtrSliceConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// UnsignedIntegerPtrSliceConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *UnsignedIntegerPtrSliceConverter) Result() ([]*uint64, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *UnsignedIntegerPtrSliceConverter) Value() []*uint64 {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *UnsignedIntegerPtrSliceConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *UnsignedIntegerPtrSliceConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// FloatPtrSliceConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *FloatPtrSliceConverter) Result() ([]*float64, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *FloatPtrSliceConverter) Value() []*float64 {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *FloatPtrSliceConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *FloatPtrSliceConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// StringPtrSliceConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *StringPtrSliceConverter) Result() ([]*string, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *StringPtrSliceConverter) Value() []*string {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *StringPtrSliceConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *StringPtrSliceConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// BoolPtrSliceConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *BoolPtrSliceConverter) Result() ([]*bool, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *BoolPtrSliceConverter) Value() []*bool {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *BoolPtrSliceConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *BoolPtrSliceConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// MapSliceConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *MapSliceConverter) Result() ([]map[string]interface{}, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *MapSliceConverter) Value() []map[string]interface{} {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *MapSliceConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *MapSliceConverter) Error() error {
+	return c.err
+}
+
+// --------------------------------------------------------------------- //
+// JSONValueSliceConverter
+// --------------------------------------------------------------------- //
+
+// Result returns the conversion result and error.
+func (c *JSONValueSliceConverter) Result() ([]interface{}, error) {
+	return c.value, c.err
+}
+
+// Value returns the conversion result.
+func (c *JSONValueSliceConverter) Value() []interface{} {
+	return c.value
+}
+
+// Interface returns the conversion result of interface type.
+func (c *JSONValueSliceConverter) Interface() interface{} {
+	return c.value
+}
+
+// Error returns an error if the conversion fails.
+func (c *JSONValueSliceConverter) Error() error {
+	return c.err
+}
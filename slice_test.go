@@ -45,3 +45,47 @@ func ExampleValueConverter_Slice() {
 	// Failed to convert from string to int: fields=[1], value="a", error=strconv.ParseInt: parsing "a": invalid syntax
 	// []henge.In{henge.In{A:"123"}, henge.In{A:"234"}} -> []henge.Out{henge.Out{A:123}, henge.Out{A:234}}
 }
+
+func ExampleValueConverter_BoolSlice() {
+	fmt.Printf("%#v\n", New([]interface{}{1, 0, "a", ""}).BoolSlice().Value())
+
+	// Output:
+	// []bool{true, false, true, false}
+}
+
+func ExampleValueConverter_StringPtrSlice() {
+	s, err := New([]interface{}{1, (*int)(nil), "a"}).StringPtrSlice().Result()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for i, v := range s {
+		if v == nil {
+			fmt.Printf("[%d] nil\n", i)
+		} else {
+			fmt.Printf("[%d] %#v\n", i, *v)
+		}
+	}
+
+	// Output:
+	// [0] "1"
+	// [1] nil
+	// [2] "a"
+}
+
+func ExampleValueConverter_Slice_tuple() {
+	type Row struct {
+		ID   int
+		Name string
+		Tags []string `henge:"-"`
+	}
+
+	var row Row
+	_ = New([]interface{}{"1", "Alice"}).Convert(&row)
+	fmt.Printf("%#v\n", row)
+	fmt.Printf("%#v\n", New(row).Slice().Value())
+
+	// Output:
+	// henge.Row{ID:1, Name:"Alice", Tags:[]string(nil)}
+	// []interface {}{1, "Alice"}
+}
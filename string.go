@@ -3,6 +3,7 @@ package henge
 import (
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 type (
@@ -55,6 +56,16 @@ func (c *ValueConverter) String() *StringConverter {
 			} else {
 				value = "false"
 			}
+		case reflect.Array, reflect.Slice:
+			if isBytesType(inT) {
+				value = c.opts.bytesOpts.encode(bytesOf(inV))
+			} else if c.opts.sliceOpts.separator != "" {
+				value, err = c.join(inV)
+			} else if inT.ConvertibleTo(outT) {
+				value = inV.Convert(outT).Interface().(string)
+			} else {
+				err = ErrUnsupportedType
+			}
 		default:
 			if inT.ConvertibleTo(outT) {
 				value = inV.Convert(outT).Interface().(string)
@@ -72,6 +83,19 @@ func (c *ValueConverter) String() *StringConverter {
 	return &StringConverter{baseConverter: c.baseConverter, value: value, err: err}
 }
 
+// join converts each element to string and joins them with the separator.
+func (c *ValueConverter) join(inV reflect.Value) (string, error) {
+	items := make([]string, inV.Len())
+	for i := 0; i < inV.Len(); i++ {
+		item, err := c.new(inV.Index(i).Interface(), c.field+"["+New(i).String().Value()+"]").String().Result()
+		if err != nil {
+			return "", err
+		}
+		items[i] = item
+	}
+	return strings.Join(items, c.opts.sliceOpts.separator), nil
+}
+
 // StringPtr converts the input to pointer of string type.
 func (c *ValueConverter) StringPtr() *StringPtrConverter {
 	return c.String().Ptr()
@@ -111,7 +135,7 @@ func (c *StringConverter) convert(outV reflect.Value) error {
 
 	switch elemOutV.Kind() {
 	case reflect.String:
-		elemOutV.Set(reflect.ValueOf(c.value))
+		elemOutV.SetString(c.value)
 	default:
 		return c.new(c.value, c.field).convert(outV)
 	}
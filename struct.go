@@ -3,6 +3,7 @@ package henge
 import (
 	"errors"
 	"reflect"
+	"unsafe"
 )
 
 type (
@@ -63,6 +64,90 @@ func (c *StructConverter) Error() error {
 	return c.err
 }
 
+// isOmitted returns true if the value should not be assigned to the field, because of WithMerge or omitempty tag.
+func (c *baseConverter) isOmitted(field structField, value interface{}) bool {
+	return (c.opts.copyOpts.merge || field.isOmitEmpty()) && isZero(value)
+}
+
+// isCopyableField returns true if the value should be copied to the field of the out type.
+// Refer: WithStructFilter, WithIncludeFields and WithExcludeFields
+func (c *baseConverter) isCopyableField(outT reflect.Type, field structField, value interface{}) bool {
+	if !c.opts.structOpts.hasFilter() {
+		return true
+	}
+	return c.opts.structOpts.isCopyable(fieldPath(c.field+"."+field.name), value, outT.FieldByIndex(field.index))
+}
+
+// callGetter calls the getter method of the name (e.g. Name() or GetName()) of the input, and returns the result.
+// The method must have no arguments and return a value, or a value and an error.
+func (c *StructConverter) callGetter(name string) (reflect.Value, bool, error) {
+	v := reflect.ValueOf(c.value)
+	if v.Kind() != reflect.Ptr {
+		// NOTE: It makes the methods with pointer receiver available.
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		v = ptr
+	}
+
+	for _, methodName := range []string{name, "Get" + name} {
+		m := v.MethodByName(methodName)
+		if !m.IsValid() || m.Type().NumIn() != 0 {
+			continue
+		}
+		switch t := m.Type(); {
+		case t.NumOut() == 1:
+			return m.Call(nil)[0], true, nil
+		case t.NumOut() == 2 && t.Out(1) == errorType:
+			out := m.Call(nil)
+			if err, _ := out[1].Interface().(error); err != nil {
+				return reflect.Value{}, false, err
+			}
+			return out[0], true, nil
+		}
+	}
+	return reflect.Value{}, false, nil
+}
+
+// accessibleField returns the field that can be accessed.
+// If it is an unexported field, it returns false unless WithUnexportedFields is specified.
+func (c *baseConverter) accessibleField(v reflect.Value) (reflect.Value, bool) {
+	if v.CanInterface() {
+		return v, true
+	}
+	if !c.opts.structOpts.copiesUnexportedFields() || !v.CanAddr() {
+		return v, false
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem(), true
+}
+
+// callSetter calls the setter method of the name (e.g. SetName(v)) of the output with the converted value.
+// If the output has no setter method of the name, it does nothing.
+func (c *baseConverter) callSetter(outV reflect.Value, name string, value interface{}) error {
+	m := outV.Addr().MethodByName("Set" + name)
+	if !m.IsValid() || m.Type().NumIn() != 1 {
+		return nil
+	}
+	if c.opts.copyOpts.merge && isZero(value) {
+		return nil
+	}
+	field, _ := outV.Type().FieldByName(name)
+	if c.opts.structOpts.hasFilter() && !c.opts.structOpts.isCopyable(fieldPath(c.field+"."+name), value, field) {
+		return nil
+	}
+
+	conv := c.new(value, c.field+"."+name)
+	argV := reflect.New(m.Type().In(0)).Elem()
+	if err := conv.convert(argV); err != nil {
+		return err
+	}
+	if out := m.Call([]reflect.Value{argV}); len(out) > 0 && out[len(out)-1].Type() == errorType {
+		if err, _ := out[len(out)-1].Interface().(error); err != nil {
+			return conv.wrapConvertError(value, argV.Type(), err)
+		}
+	}
+	return nil
+}
+
 func (c *StructConverter) convert(outV reflect.Value) error {
 	if c.err != nil {
 		return c.wrapConvertError(c.value, outV.Type(), c.err)
@@ -93,20 +178,31 @@ func (c *StructConverter) convert(outV reflect.Value) error {
 	switch elemOutV.Kind() {
 	case reflect.Struct:
 		inV := reflect.Indirect(reflect.ValueOf(c.value))
+		if c.opts.structOpts.copiesUnexportedFields() && !inV.CanAddr() {
+			// NOTE: unexported fields can be read only if it is addressable.
+			v := reflect.New(inV.Type()).Elem()
+			v.Set(inV)
+			inV = v
+		}
+
+		outFields := getStructFields(elemOutV.Type())
 
 		// NOTE: Types that are simply converted (it also copies private fields)
-		if inV.Type().ConvertibleTo(elemOutV.Type()) {
-			elemOutV.Set(inV.Convert(elemOutV.Type()))
+		//       In merge mode, it is converted for each field to keep the fields of the output.
+		if inV.Type().ConvertibleTo(elemOutV.Type()) && !c.opts.copyOpts.merge && !c.opts.structOpts.hasFilter() &&
+			!c.opts.structOpts.skipsUnexportedFields() && !hasOmitEmptyField(outFields) {
+			elemOutV.Set(reflect.ValueOf(c.copyValue(inV.Interface())).Convert(elemOutV.Type()))
 			break
 		}
 
 		inFields := getStructFields(inV.Type())
 	Loop:
-		for _, outField := range getStructFields(elemOutV.Type()) {
-			if outField.isIgnore() {
+		for _, outField := range outFields {
+			if outField.isIgnore() || !outField.isVisible(c.opts.structOpts.groups) {
 				continue
 			}
 
+			var v reflect.Value
 			if f, ok := inV.Type().FieldByName(outField.name); ok {
 				inField := func() structField {
 					for _, field := range inFields {
@@ -116,45 +212,82 @@ func (c *StructConverter) convert(outV reflect.Value) error {
 					}
 					panic("field not found")
 				}()
-				if inField.isIgnore() {
+				if inField.isIgnore() || !inField.isVisible(c.opts.structOpts.groups) {
 					continue
 				}
 
-				v := inV.FieldByIndex(inField.index)
 				// NOTE: private field
-				if !v.CanInterface() {
+				if v, ok = c.accessibleField(inV.FieldByIndex(inField.index)); !ok {
 					continue
 				}
-				conv := c.new(v.Interface(), c.field+"."+outField.name)
-
-				// NOTE: initialized embedded field.
-				anchor := elemOutV
-				for i, index := range outField.index {
-					v := anchor.Field(index)
-					if v.Kind() == reflect.Ptr {
-						if !v.CanSet() {
+			} else if c.opts.structOpts.getterMethods {
+				if v, ok, err = c.callGetter(outField.name); err != nil {
+					outT := elemOutV.Type().FieldByIndex(outField.index).Type
+					err = c.new(c.value, c.field+"."+outField.name).wrapConvertError(c.value, outT, err)
+					goto failed
+				} else if !ok {
+					continue
+				}
+			} else {
+				continue
+			}
+
+			if c.isOmitted(outField, v.Interface()) {
+				continue
+			}
+			if !c.isCopyableField(elemOutV.Type(), outField, v.Interface()) {
+				continue
+			}
+			conv := c.new(v.Interface(), c.field+"."+outField.name)
+
+			// NOTE: initialized embedded field.
+			anchor, target := elemOutV, reflect.Value{}
+			for i, index := range outField.index {
+				v, ok := c.accessibleField(anchor.Field(index))
+				// NOTE: The exported fields promoted through the unexported embedded struct are accessible,
+				//       so only the last field and the nil pointer to be initialized are checked.
+				if !ok && (i == len(outField.index)-1 || (v.Kind() == reflect.Ptr && v.IsNil())) {
+					continue Loop
+				}
+				target = v
+				if v.Kind() == reflect.Ptr {
+					if conv.isNil {
+						if i == len(outField.index)-1 { // last index only.
+							// NOTE: set nil.
+							v.Set(reflect.New(v.Type()).Elem())
+							continue Loop
+						} else if v.IsNil() {
 							continue Loop
 						}
-						if conv.isNil {
-							if i == len(outField.index)-1 { // last index only.
-								// NOTE: set nil.
-								v.Set(reflect.New(v.Type()).Elem())
-								continue Loop
-							} else if v.IsNil() {
-								continue Loop
-							}
-						}
-						if v.IsNil() {
-							v.Set(reflect.New(v.Type().Elem()))
-						}
-						anchor = v.Elem()
-					} else {
-						anchor = v
 					}
+					if v.IsNil() {
+						v.Set(reflect.New(v.Type().Elem()))
+					}
+					anchor = v.Elem()
+				} else {
+					anchor = v
 				}
+			}
+
+			if err = conv.mergeInto(target); err != nil {
+				goto failed
+			}
+		}
 
-				target := elemOutV.FieldByIndex(outField.index)
-				if err = conv.convert(target); err != nil {
+		if c.opts.structOpts.setterMethods {
+			for _, inField := range inFields {
+				// NOTE: Only the fields that can be accessed by the name.
+				if f, ok := inV.Type().FieldByName(inField.name); !ok || !inField.isMatch(f) {
+					continue
+				}
+				if inField.isIgnore() || !inField.isVisible(c.opts.structOpts.groups) || hasExportedField(elemOutV.Type(), inField.name) {
+					continue
+				}
+				v, ok := c.accessibleField(inV.FieldByIndex(inField.index))
+				if !ok {
+					continue
+				}
+				if err = c.callSetter(elemOutV, inField.name, v.Interface()); err != nil {
 					goto failed
 				}
 			}
@@ -2,6 +2,9 @@ package henge
 
 import (
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -9,7 +12,12 @@ const (
 )
 
 type structTag struct {
-	ignore bool
+	ignore    bool
+	key       bool
+	omitEmpty bool
+	groups    []string
+	index     int
+	hasIndex  bool
 }
 
 // newStructTag is create a `structTag` from `reflect.StructField`
@@ -20,10 +28,42 @@ func newStructTag(f reflect.StructField) structTag {
 	case "-":
 		return structTag{ignore: true}
 	default:
-		return structTag{ignore: false}
+		tag := structTag{ignore: false}
+		for _, opt := range strings.Split(value, ",") {
+			switch {
+			case opt == "key":
+				tag.key = true
+			case opt == "omitempty":
+				tag.omitEmpty = true
+			case strings.HasPrefix(opt, "group="):
+				// NOTE: Each group is specified with the prefix, so that it is not confused with the other options. (e.g. `henge:"key,group=admin,group=internal"`)
+				tag.groups = append(tag.groups, strings.TrimPrefix(opt, "group="))
+			case strings.HasPrefix(opt, "index="):
+				if index, err := strconv.Atoi(strings.TrimPrefix(opt, "index=")); err == nil && index >= 0 {
+					tag.index, tag.hasIndex = index, true
+				}
+			}
+		}
+		return tag
 	}
 }
 
+// isVisible returns true if the field belongs to any of the groups.
+// If the field or the groups are not specified, it is visible.
+func (t *structTag) isVisible(groups []string) bool {
+	if len(t.groups) == 0 || len(groups) == 0 {
+		return true
+	}
+	for _, g := range t.groups {
+		for _, group := range groups {
+			if g == group {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // getStructFieldIndexes returns all field indexes including embedded fields of the type.
 func getStructFieldIndexes(t reflect.Type) [][]int {
 	fieldIndexes := make([][]int, 0)
@@ -98,3 +138,141 @@ func (f *structField) isMatch(rf reflect.StructField) bool {
 	}
 	return true
 }
+
+// isKey returns true if the field is tagged as the key field.
+func (f *structField) isKey() bool {
+	return len(f.tags) > 0 && f.tags[len(f.tags)-1].key
+}
+
+// isVisible returns true if the field and its ancestors are visible to the groups.
+func (f *structField) isVisible(groups []string) bool {
+	for _, t := range f.tags {
+		if !t.isVisible(groups) {
+			return false
+		}
+	}
+	return true
+}
+
+// isOmitEmpty returns true if the field is tagged with `henge:"omitempty"`.
+func (f *structField) isOmitEmpty() bool {
+	return len(f.tags) > 0 && f.tags[len(f.tags)-1].omitEmpty
+}
+
+// hasOmitEmptyField returns true if any of the fields is tagged with `henge:"omitempty"`.
+func hasOmitEmptyField(fields []structField) bool {
+	for _, field := range fields {
+		if field.isOmitEmpty() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExportedField returns true if the struct type has the exported field of the name.
+func hasExportedField(t reflect.Type, name string) bool {
+	f, ok := t.FieldByName(name)
+	return ok && f.PkgPath == ""
+}
+
+// tupleField is a field of struct with the position in the tuple (slice).
+type tupleField struct {
+	structField
+	position int
+}
+
+// getStructTupleFields returns the fields in the order of the tuple (slice) of the type.
+// If any field is tagged with `henge:"index=N"`, only the tagged fields are used, otherwise the exported fields are used in order.
+// The positions without the field are not included, and it returns ErrInvalidTag if the same index is tagged more than once.
+func getStructTupleFields(t reflect.Type) ([]tupleField, error) {
+	var fields, indexedFields []tupleField
+	for _, field := range getStructFields(t) {
+		if len(field.index) != 1 || field.isIgnore() {
+			continue
+		}
+		if field.tags[0].hasIndex {
+			indexedFields = append(indexedFields, tupleField{structField: field, position: field.tags[0].index})
+		} else if t.Field(field.index[0]).PkgPath == "" {
+			fields = append(fields, tupleField{structField: field, position: len(fields)})
+		}
+	}
+	if len(indexedFields) == 0 {
+		return fields, nil
+	}
+
+	sort.SliceStable(indexedFields, func(i, j int) bool {
+		return indexedFields[i].position < indexedFields[j].position
+	})
+	for i := 1; i < len(indexedFields); i++ {
+		if indexedFields[i-1].position == indexedFields[i].position {
+			return nil, ErrInvalidTag
+		}
+	}
+	return indexedFields, nil
+}
+
+// tupleLength returns the length of the tuple that has the fields.
+func tupleLength(fields []tupleField) int {
+	if len(fields) == 0 {
+		return 0
+	}
+	return fields[len(fields)-1].position + 1
+}
+
+// getStructKeyField returns the key field of the type.
+// If the name is empty, it returns the field tagged with `henge:"key"`.
+func getStructKeyField(t reflect.Type, name string) (structField, bool) {
+	var (
+		keyField structField
+		found    bool
+	)
+	for _, field := range getStructFields(t) {
+		if field.isIgnore() {
+			continue
+		}
+		if (name != "" && field.name == name) || (name == "" && field.isKey()) {
+			// NOTE: the higher-level field takes precedence.
+			if !found || len(field.index) < len(keyField.index) {
+				keyField, found = field, true
+			}
+		}
+	}
+	return keyField, found
+}
+
+// fieldPath returns the path of the field name used in errors, without the slice indexes and the map keys.
+// (e.g. ".Users[0].Name" -> "Users.Name")
+func fieldPath(fieldName string) string {
+	var (
+		b     strings.Builder
+		depth int
+	)
+	for _, r := range fieldName {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+	return strings.TrimPrefix(b.String(), ".")
+}
+
+// fieldByIndex returns the nested field corresponding to index.
+// Unlike reflect.Value.FieldByIndex, it returns false instead of panic if it steps through a nil pointer.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 {
+			if v.Kind() == reflect.Ptr {
+				if v.IsNil() {
+					return reflect.Value{}, false
+				}
+				v = v.Elem()
+			}
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
@@ -43,9 +43,25 @@ func ExampleGetStructFields() {
 	}
 
 	// Output:
-	// {Embedded1 [0] [{true}]}
-	// {Embedded2 [0 0] [{true} {false}]}
-	// {A [0 0 0] [{true} {false} {true}]}
-	// {B [0 1] [{true} {false}]}
-	// {A [1] [{false}]}
+	// {Embedded1 [0] [{true false false [] 0 false}]}
+	// {Embedded2 [0 0] [{true false false [] 0 false} {false false false [] 0 false}]}
+	// {A [0 0 0] [{true false false [] 0 false} {false false false [] 0 false} {true false false [] 0 false}]}
+	// {B [0 1] [{true false false [] 0 false} {false false false [] 0 false}]}
+	// {A [1] [{false false false [] 0 false}]}
+}
+
+func Example_newStructTag() {
+	type T struct {
+		A string `henge:"key,group=admin,group=internal,omitempty"`
+		B string `henge:"group=public,index=2"`
+	}
+
+	t := reflect.ValueOf(T{}).Type()
+	for i := 0; i < t.NumField(); i++ {
+		fmt.Printf("%+v\n", newStructTag(t.Field(i)))
+	}
+
+	// Output:
+	// {ignore:false key:true omitEmpty:true groups:[admin internal] index:0 hasIndex:false}
+	// {ignore:false key:false omitEmpty:false groups:[public] index:2 hasIndex:true}
 }
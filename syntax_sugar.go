@@ -49,3 +49,93 @@ func ToFloatPtr(i interface{}, fs ...ConverterOption) *float64 {
 func ToBoolPtr(i interface{}, fs ...ConverterOption) *bool {
 	return New(i, fs...).BoolPtr().Value()
 }
+
+// ToStringSlice is equiv to New(i, fs...).StringSlice().Value()
+func ToStringSlice(i interface{}, fs ...ConverterOption) []string {
+	return New(i, fs...).StringSlice().Value()
+}
+
+// ToIntSlice is equiv to New(i, fs...).IntSlice().Value()
+func ToIntSlice(i interface{}, fs ...ConverterOption) []int64 {
+	return New(i, fs...).IntSlice().Value()
+}
+
+// ToUintSlice is equiv to New(i, fs...).UintSlice().Value()
+func ToUintSlice(i interface{}, fs ...ConverterOption) []uint64 {
+	return New(i, fs...).UintSlice().Value()
+}
+
+// ToFloatSlice is equiv to New(i, fs...).FloatSlice().Value()
+func ToFloatSlice(i interface{}, fs ...ConverterOption) []float64 {
+	return New(i, fs...).FloatSlice().Value()
+}
+
+// ToBoolSlice is equiv to New(i, fs...).BoolSlice().Value()
+func ToBoolSlice(i interface{}, fs ...ConverterOption) []bool {
+	return New(i, fs...).BoolSlice().Value()
+}
+
+// ToStringPtrSlice is equiv to New(i, fs...).StringPtrSlice().Value()
+func ToStringPtrSlice(i interface{}, fs ...ConverterOption) []*string {
+	return New(i, fs...).StringPtrSlice().Value()
+}
+
+// ToIntPtrSlice is equiv to New(i, fs...).IntPtrSlice().Value()
+func ToIntPtrSlice(i interface{}, fs ...ConverterOption) []*int64 {
+	return New(i, fs...).IntPtrSlice().Value()
+}
+
+// ToUintPtrSlice is equiv to New(i, fs...).UintPtrSlice().Value()
+func ToUintPtrSlice(i interface{}, fs ...ConverterOption) []*uint64 {
+	return New(i, fs...).UintPtrSlice().Value()
+}
+
+// ToFloatPtrSlice is equiv to New(i, fs...).FloatPtrSlice().Value()
+func ToFloatPtrSlice(i interface{}, fs ...ConverterOption) []*float64 {
+	return New(i, fs...).FloatPtrSlice().Value()
+}
+
+// ToBoolPtrSlice is equiv to New(i, fs...).BoolPtrSlice().Value()
+func ToBoolPtrSlice(i interface{}, fs ...ConverterOption) []*bool {
+	return New(i, fs...).BoolPtrSlice().Value()
+}
+
+// ToMapSlice is equiv to New(i, fs...).MapSlice().Value()
+func ToMapSlice(i interface{}, fs ...ConverterOption) []map[string]interface{} {
+	return New(i, fs...).MapSlice().Value()
+}
+
+// ToJSONValueSlice is equiv to New(i, fs...).JSONValueSlice().Value()
+func ToJSONValueSlice(i interface{}, fs ...ConverterOption) []interface{} {
+	return New(i, fs...).JSONValueSlice().Value()
+}
+
+// ToStringMap is equiv to New(i, fs...).StringMap().Value()
+func ToStringMap(i interface{}, fs ...ConverterOption) map[string]interface{} {
+	return New(i, fs...).StringMap().Value()
+}
+
+// ToStringStringMap is equiv to New(i, fs...).StringStringMap().Value()
+func ToStringStringMap(i interface{}, fs ...ConverterOption) map[string]string {
+	return New(i, fs...).StringStringMap().Value()
+}
+
+// ToStringIntMap is equiv to New(i, fs...).StringIntMap().Value()
+func ToStringIntMap(i interface{}, fs ...ConverterOption) map[string]int64 {
+	return New(i, fs...).StringIntMap().Value()
+}
+
+// ToStringUintMap is equiv to New(i, fs...).StringUintMap().Value()
+func ToStringUintMap(i interface{}, fs ...ConverterOption) map[string]uint64 {
+	return New(i, fs...).StringUintMap().Value()
+}
+
+// ToStringFloatMap is equiv to New(i, fs...).StringFloatMap().Value()
+func ToStringFloatMap(i interface{}, fs ...ConverterOption) map[string]float64 {
+	return New(i, fs...).StringFloatMap().Value()
+}
+
+// ToStringBoolMap is equiv to New(i, fs...).StringBoolMap().Value()
+func ToStringBoolMap(i interface{}, fs ...ConverterOption) map[string]bool {
+	return New(i, fs...).StringBoolMap().Value()
+}
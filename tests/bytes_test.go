@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBytesConverter_interface(t *testing.T) {
+	var _ henge.Converter = henge.New(nil).Bytes()
+}
+
+func TestBytesConverter_nil(t *testing.T) {
+	b, err := henge.New(([]byte)(nil)).Bytes().Result()
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	b, err = henge.New((*string)(nil)).Bytes().Result()
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	b, err = henge.New(1).Bytes().Result()
+	assert.EqualError(t, err, "Failed to convert from int to []uint8: fields=, value=1, error=unsupported type")
+	assert.Nil(t, b)
+}
+
+func TestBytesConverter_Convert_array(t *testing.T) {
+	var a [4]byte
+	assert.NoError(t, henge.New("abc").Convert(&a))
+	assert.Equal(t, [4]byte{'a', 'b', 'c', 0}, a)
+
+	var s string
+	assert.NoError(t, henge.New(a).Convert(&s))
+	assert.Equal(t, "abc\x00", s)
+}
+
+func TestBytesConverter_Convert_namedType(t *testing.T) {
+	type Raw []byte
+	var raw Raw
+	assert.NoError(t, henge.New("abc").Convert(&raw))
+	assert.Equal(t, Raw("abc"), raw)
+
+	var s *string
+	assert.NoError(t, henge.New(raw, henge.WithHexBytes()).Convert(&s))
+	if assert.NotNil(t, s) {
+		assert.Equal(t, "616263", *s)
+	}
+}
+
+func TestBytesConverter_Convert_slice(t *testing.T) {
+	var b []byte
+	assert.NoError(t, henge.New([]int{97, 98}).Bytes().Convert(&b))
+	assert.Equal(t, []byte("ab"), b)
+
+	assert.Error(t, henge.New([]int{256}).Bytes().Error())
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClone(t *testing.T) {
+	assert.Nil(t, henge.Clone(nil))
+	assert.Equal(t, 1, henge.Clone(1))
+	assert.Equal(t, (*int)(nil), henge.Clone((*int)(nil)))
+	assert.Equal(t, []int(nil), henge.Clone([]int(nil)))
+
+	now := time.Now()
+	assert.Equal(t, now, henge.Clone(now))
+
+	in := map[string]interface{}{"a": []interface{}{map[string]int{"b": 1}}, "c": [1][]int{{1}}}
+	out := henge.Clone(in).(map[string]interface{})
+	assert.Equal(t, in, out)
+	out["a"].([]interface{})[0].(map[string]int)["b"] = 2
+	out["c"].([1][]int)[0][0] = 2
+	assert.Equal(t, 1, in["a"].([]interface{})[0].(map[string]int)["b"])
+	assert.Equal(t, 1, in["c"].([1][]int)[0][0])
+}
+
+func TestClone_sharedReference(t *testing.T) {
+	type T struct {
+		A *int
+		B *int
+		S []int
+		U []int
+	}
+	i := 1
+	s := []int{1, 2}
+	out := henge.Clone(&T{A: &i, B: &i, S: s, U: s}).(*T)
+	assert.True(t, out.A == out.B)
+	assert.False(t, out.A == &i)
+	out.S[0] = 3
+	assert.Equal(t, 3, out.U[0])
+	assert.Equal(t, 1, s[0])
+}
+
+func TestWithDeepCopy(t *testing.T) {
+	type Embedded struct {
+		X string `henge:"-"`
+	}
+	type T struct {
+		Embedded
+		Payload interface{}
+		Ptr     *int
+	}
+
+	i := 1
+	in := T{Embedded: Embedded{X: "x"}, Payload: map[string]interface{}{"a": []int{1}}, Ptr: &i}
+	var out T
+	assert.NoError(t, henge.New(in, henge.WithDeepCopy()).Convert(&out))
+	assert.Equal(t, "", out.X)
+	assert.Equal(t, in.Payload, out.Payload)
+	assert.False(t, in.Ptr == out.Ptr)
+	out.Payload.(map[string]interface{})["a"].([]int)[0] = 2
+	assert.Equal(t, 1, in.Payload.(map[string]interface{})["a"].([]int)[0])
+
+	var m map[string]interface{}
+	assert.NoError(t, henge.New(in.Payload, henge.WithDeepCopy()).Convert(&m))
+	m["a"].([]int)[0] = 3
+	assert.Equal(t, 1, in.Payload.(map[string]interface{})["a"].([]int)[0])
+}
+
+func TestWithDeepCopy_sharedReference(t *testing.T) {
+	type T struct {
+		A *int
+		B *int
+	}
+
+	i := 1
+	in := T{A: &i, B: &i}
+	var out T
+	assert.NoError(t, henge.New(in, henge.WithDeepCopy()).Convert(&out))
+	assert.False(t, in.A == out.A)
+	assert.True(t, out.A == out.B)
+}
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type cycleIn struct {
+	Name     string
+	Parent   *cycleIn
+	Children []*cycleIn
+}
+
+type cycleOut struct {
+	Name     string
+	Parent   *cycleOut
+	Children []*cycleOut
+}
+
+func newCycleIn() *cycleIn {
+	root := &cycleIn{Name: "root"}
+	root.Children = []*cycleIn{{Name: "child", Parent: root}}
+	return root
+}
+
+func TestCycle_Struct(t *testing.T) {
+	var out cycleOut
+	err := henge.New(newCycleIn()).Convert(&out)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Children[0].Parent", convertError.Field)
+		assert.Equal(t, henge.ErrCycle, convertError.Err)
+	}
+
+	var outP *cycleOut
+	assert.NoError(t, henge.New(newCycleIn(), henge.WithDeepCopy()).Convert(&outP))
+	if assert.NotNil(t, outP) && assert.Len(t, outP.Children, 1) {
+		assert.Equal(t, "child", outP.Children[0].Name)
+		assert.True(t, outP.Children[0].Parent == outP)
+	}
+
+	// NOTE: The shared references that are not cycles are converted respectively.
+	child := &cycleIn{Name: "child"}
+	in := &cycleIn{Name: "root", Children: []*cycleIn{child, child}}
+	assert.NoError(t, henge.New(in).Convert(&outP))
+	if assert.Len(t, outP.Children, 2) {
+		assert.Equal(t, "child", outP.Children[1].Name)
+	}
+}
+
+func TestCycle_StructValue(t *testing.T) {
+	type Out struct {
+		Name     string
+		Children []Out
+	}
+
+	var out cycleOut
+	assert.NoError(t, henge.New(newCycleIn(), henge.WithDeepCopy()).Convert(&out))
+	if assert.Len(t, out.Children, 1) {
+		assert.True(t, out.Children[0].Parent == &out)
+	}
+
+	// NOTE: The value type cannot hold the circular reference.
+	in := &cycleIn{Name: "root"}
+	in.Children = []*cycleIn{in}
+	var out2 Out
+	err := henge.New(in, henge.WithDeepCopy()).Convert(&out2)
+	assert.True(t, errors.Is(err, henge.ErrCycle))
+}
+
+func TestCycle_Map(t *testing.T) {
+	in := &cycleIn{Name: "root"}
+	in.Parent = in
+	_, err := henge.New(in).Map().Result()
+	assert.True(t, errors.Is(err, henge.ErrCycle))
+
+	m, err := henge.New(in, henge.WithDeepCopy()).Map().Result()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "root", m["Name"])
+		assert.True(t, reflect.ValueOf(m["Parent"]).Pointer() == reflect.ValueOf(m).Pointer())
+	}
+
+	_, err = henge.New(newCycleIn()).JSONValue().Result()
+	assert.True(t, errors.Is(err, henge.ErrCycle))
+
+	mIn := map[string]interface{}{"name": "a"}
+	mIn["self"] = mIn
+	_, err = henge.New(mIn).Map().Result()
+	assert.True(t, errors.Is(err, henge.ErrCycle))
+	assert.NotPanics(t, func() { _ = err.Error() })
+
+	var out map[string]interface{}
+	err = henge.New(mIn).Convert(&out)
+	assert.True(t, errors.Is(err, henge.ErrCycle))
+	assert.NotPanics(t, func() { _ = err.Error() })
+}
+
+func TestCycle_Slice(t *testing.T) {
+	in := []interface{}{"a", nil}
+	in[1] = in
+
+	var out []string
+	err := henge.New(in).Convert(&out)
+	assert.Error(t, err)
+	assert.NotPanics(t, func() { _ = err.Error() })
+	assert.Equal(t,
+		"Failed to convert from []interface {} to string: fields=[1], value=[]interface {}{<circular reference>}, error=unsupported type",
+		err.Error(),
+	)
+}
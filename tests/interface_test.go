@@ -0,0 +1,224 @@
+package tests
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type shape interface {
+	Area() float64
+}
+
+type circle struct {
+	Radius float64
+}
+
+func (c circle) Area() float64 {
+	return 3 * c.Radius * c.Radius
+}
+
+type rect struct {
+	Width  float64
+	Height float64
+}
+
+func (r *rect) Area() float64 {
+	return r.Width * r.Height
+}
+
+var shapeTypes = map[string]reflect.Type{
+	"circle": reflect.TypeOf(circle{}),
+	"rect":   reflect.TypeOf(&rect{}),
+}
+
+func TestInterface_NotConvertible(t *testing.T) {
+	var r io.Reader
+	assert.NoError(t, henge.New(strings.NewReader("a")).Convert(&r))
+	assert.NotNil(t, r)
+
+	err := henge.New("a").Convert(&r)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, henge.ErrNotConvertible, convertError.Err)
+	}
+
+	// NOTE: The methods with pointer receiver are available.
+	var s shape
+	assert.NoError(t, henge.New(rect{Width: 1, Height: 2}).Convert(&s))
+	assert.Equal(t, &rect{Width: 1, Height: 2}, s)
+
+	var out struct {
+		Shape shape
+	}
+	err = henge.New(map[string]interface{}{"Shape": 1}).Convert(&out)
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Shape", convertError.Field)
+		assert.Equal(t, henge.ErrNotConvertible, convertError.Err)
+	}
+}
+
+func TestWithImplementations(t *testing.T) {
+	type Drawing struct {
+		Name   string
+		Shapes []shape
+		Main   shape
+	}
+
+	opt := henge.WithImplementations(reflect.TypeOf((*shape)(nil)).Elem(), "type", shapeTypes)
+	in := map[string]interface{}{
+		"Name": "a",
+		"Shapes": []interface{}{
+			map[string]interface{}{"type": "circle", "Radius": "1"},
+			map[string]interface{}{"type": "rect", "Width": 2, "Height": 3},
+		},
+		"Main": map[string]interface{}{"type": "circle", "Radius": 2},
+	}
+
+	var out Drawing
+	assert.NoError(t, henge.New(in, opt).Convert(&out))
+	assert.Equal(t, Drawing{
+		Name:   "a",
+		Shapes: []shape{circle{Radius: 1}, &rect{Width: 2, Height: 3}},
+		Main:   circle{Radius: 2},
+	}, out)
+
+	err := henge.New(map[string]interface{}{"Main": map[string]interface{}{"type": "triangle"}}, opt).Convert(&out)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Main", convertError.Field)
+		assert.Equal(t, henge.ErrNotConvertible, convertError.Err)
+	}
+
+	// NOTE: The concrete value is assigned as is.
+	out = Drawing{}
+	assert.NoError(t, henge.New(struct{ Main circle }{Main: circle{Radius: 3}}, opt).Convert(&out))
+	assert.Equal(t, circle{Radius: 3}, out.Main)
+}
+
+type event interface {
+	EventName() string
+}
+
+type createdEvent struct {
+	ID   int
+	Name string
+}
+
+func (e createdEvent) EventName() string {
+	return "created"
+}
+
+type deletedEvent struct {
+	ID int
+}
+
+func (e *deletedEvent) EventName() string {
+	return "deleted"
+}
+
+func TestWithDiscriminator(t *testing.T) {
+	opt := henge.WithDiscriminator("kind", map[string]reflect.Type{
+		"created": reflect.TypeOf(createdEvent{}),
+		"deleted": reflect.TypeOf(&deletedEvent{}),
+		"circle":  reflect.TypeOf(circle{}),
+	})
+	in := []interface{}{
+		map[string]interface{}{"kind": "created", "ID": "1", "Name": "a"},
+		map[string]interface{}{"kind": "deleted", "ID": 2},
+	}
+
+	var events []event
+	assert.NoError(t, henge.New(in, opt).Convert(&events))
+	assert.Equal(t, []event{createdEvent{ID: 1, Name: "a"}, &deletedEvent{ID: 2}}, events)
+
+	// NOTE: The discriminator field is added when converting to map.
+	out, err := henge.New(events, opt).JSONArray().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"kind": "created", "ID": int64(1), "Name": "a"},
+		map[string]interface{}{"kind": "deleted", "ID": int64(2)},
+	}, out)
+
+	var m map[string]interface{}
+	assert.NoError(t, henge.New(events[1], opt).Convert(&m))
+	assert.Equal(t, map[string]interface{}{"kind": "deleted", "ID": 2}, m)
+
+	// NOTE: The type that does not implement the interface is not selected.
+	err = henge.New([]interface{}{map[string]interface{}{"kind": "circle"}}, opt).Convert(&events)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, "[0]", convertError.Field)
+		assert.Equal(t, henge.ErrNotConvertible, convertError.Err)
+	}
+
+	var shapes []shape
+	assert.NoError(t, henge.New([]interface{}{map[string]interface{}{"kind": "circle", "Radius": 1}}, opt).Convert(&shapes))
+	assert.Equal(t, []shape{circle{Radius: 1}}, shapes)
+
+	// NOTE: It does not apply to interface{}.
+	var values []interface{}
+	assert.NoError(t, henge.New(in, opt).Convert(&values))
+	assert.Equal(t, in, values)
+
+	var untyped, expected map[string]interface{}
+	assert.NoError(t, henge.New(map[string]interface{}{"a": in[0]}).Convert(&expected))
+	assert.NoError(t, henge.New(map[string]interface{}{"a": in[0]}, opt).Convert(&untyped))
+	assert.Equal(t, expected, untyped)
+	assert.IsType(t, map[interface{}]interface{}{}, untyped["a"])
+
+	// NOTE: The first name in ascending order is used, if multiple names have the type.
+	aliasOpt := henge.WithDiscriminator("kind", map[string]reflect.Type{
+		"created":  reflect.TypeOf(createdEvent{}),
+		"added":    reflect.TypeOf(createdEvent{}),
+		"inserted": reflect.TypeOf(createdEvent{}),
+	})
+	for i := 0; i < 10; i++ {
+		m, err := henge.New(createdEvent{ID: 1}, aliasOpt).JSONObject().Result()
+		assert.NoError(t, err)
+		assert.Equal(t, "added", m["kind"])
+	}
+}
+
+func TestWithNormalizedInterface(t *testing.T) {
+	type Config struct {
+		Name    string
+		Value   interface{}
+		Options map[string]interface{}
+	}
+
+	in := map[interface{}]interface{}{
+		"Name": "a",
+		"Value": map[interface{}]interface{}{
+			1:      []interface{}{int8(1), uint(2), float32(0.5), true, map[interface{}]interface{}{true: nil}},
+			"text": [2]string{"b", "c"},
+		},
+		"Options": map[interface{}]interface{}{"x": map[interface{}]interface{}{"y": int32(3)}},
+	}
+
+	var out Config
+	assert.NoError(t, henge.New(in, henge.WithNormalizedInterface()).Convert(&out))
+	assert.Equal(t, Config{
+		Name: "a",
+		Value: map[string]interface{}{
+			"1":    []interface{}{int64(1), uint64(2), float64(0.5), true, map[string]interface{}{"true": nil}},
+			"text": []interface{}{"b", "c"},
+		},
+		Options: map[string]interface{}{"x": map[string]interface{}{"y": int64(3)}},
+	}, out)
+
+	// NOTE: The value is assigned as is without the option.
+	out = Config{}
+	assert.NoError(t, henge.New(in).Convert(&out))
+	assert.Equal(t, in["Value"], out.Value)
+
+	// NOTE: The interfaces that has methods are not normalized.
+	var s shape
+	assert.NoError(t, henge.New(circle{Radius: 1}, henge.WithNormalizedInterface()).Convert(&s))
+	assert.Equal(t, circle{Radius: 1}, s)
+}
@@ -1,9 +1,13 @@
 package tests
 
 import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
 	"github.com/soranoba/henge/v2"
 	"github.com/stretchr/testify/assert"
-	"testing"
 )
 
 func TestJSONValueConverter_interface(t *testing.T) {
@@ -23,3 +27,61 @@ func TestJSONValueConverter_nil(t *testing.T) {
 	assert.Nil(t, val)
 	assert.NoError(t, err)
 }
+
+func TestUnmarshalJSON(t *testing.T) {
+	type Item struct {
+		ID    uint64
+		Price float64
+	}
+	type Order struct {
+		Items []Item
+		Meta  map[string]interface{}
+	}
+
+	var out Order
+	assert.NoError(t, henge.UnmarshalJSON([]byte(`{"Items": [{"ID": 18446744073709551615, "Price": "1.5"}], "Meta": {"a": 1}}`), &out))
+	assert.Equal(t, Order{
+		Items: []Item{{ID: math.MaxUint64, Price: 1.5}},
+		Meta:  map[string]interface{}{"a": json.Number("1")},
+	}, out)
+
+	out = Order{}
+	assert.NoError(t, henge.UnmarshalJSON([]byte(`{"Meta": {"a": 1}}`), &out, henge.WithNormalizedInterface()))
+	assert.Equal(t, map[string]interface{}{"a": int64(1)}, out.Meta)
+
+	err := henge.UnmarshalJSON([]byte(`{"Items": [{}, {"ID": -1}]}`), &out)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Items[1].ID", convertError.Field)
+		assert.Equal(t, henge.ErrNegativeNumber, convertError.Err)
+	}
+
+	var syntaxError *json.SyntaxError
+	assert.True(t, errors.As(henge.UnmarshalJSON([]byte(`{"a" 1}`), &out), &syntaxError))
+	assert.True(t, errors.Is(henge.UnmarshalJSON([]byte(`{} {}`), &out), henge.ErrTrailingData))
+
+	var i int
+	assert.NoError(t, henge.UnmarshalJSON([]byte(`"10"`), &i))
+	assert.Equal(t, 10, i)
+}
+
+func TestMarshalJSON(t *testing.T) {
+	type Item struct {
+		ID    uint64
+		Price float64
+		Tags  map[interface{}]interface{}
+	}
+
+	b, err := henge.MarshalJSON(map[string]interface{}{
+		"items": []Item{{ID: math.MaxUint64, Price: 1.5, Tags: map[interface{}]interface{}{1: true}}},
+		"count": json.Number("1"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"count":1,"items":[{"ID":18446744073709551615,"Price":1.5,"Tags":{"1":true}}]}`, string(b))
+
+	_, err = henge.MarshalJSON(map[string]interface{}{"a": json.Number("x")})
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".a", convertError.Field)
+	}
+}
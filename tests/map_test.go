@@ -2,7 +2,9 @@ package tests
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +14,12 @@ import (
 
 func TestMapConverter_interface(t *testing.T) {
 	var _ henge.Converter = henge.New(nil).Map()
+	var _ henge.Converter = henge.New(nil).StringMap()
+	var _ henge.Converter = henge.New(nil).StringStringMap()
+	var _ henge.Converter = henge.New(nil).StringIntMap()
+	var _ henge.Converter = henge.New(nil).StringUintMap()
+	var _ henge.Converter = henge.New(nil).StringFloatMap()
+	var _ henge.Converter = henge.New(nil).StringBoolMap()
 }
 
 func TestMapConverter_privateField(t *testing.T) {
@@ -105,3 +113,130 @@ func TestMapConverter_Error(t *testing.T) {
 		assert.Equal(t, reflect.TypeOf(int(1)), convertError.DstType)
 	}
 }
+
+func TestMapConverter_typedMap(t *testing.T) {
+	type In struct {
+		A int
+		B string
+	}
+	in := In{A: 1, B: "2"}
+
+	assert.Equal(t, map[string]interface{}{"A": 1, "B": "2"}, henge.ToStringMap(in))
+	assert.Equal(t, map[string]string{"A": "1", "B": "2"}, henge.ToStringStringMap(in))
+	assert.Equal(t, map[string]int64{"A": 1, "B": 2}, henge.ToStringIntMap(in))
+	assert.Equal(t, map[string]uint64{"A": 1, "B": 2}, henge.ToStringUintMap(in))
+	assert.Equal(t, map[string]float64{"A": 1, "B": 2}, henge.ToStringFloatMap(in))
+	assert.Equal(t, map[string]bool{"A": true, "B": true}, henge.ToStringBoolMap(in))
+
+	m, err := henge.New((map[string]int)(nil)).StringIntMap().Result()
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+
+	_, err = henge.New(map[string]string{"a": "x"}).StringIntMap().Result()
+	assert.EqualError(t, err, "Failed to convert from string to int64: fields=[a], value=\"x\", error=strconv.ParseInt: parsing \"x\": invalid syntax")
+}
+
+func TestMapConverter_keyValueSlice(t *testing.T) {
+	s, err := henge.New(map[string]int{"a": 1}).Slice().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{henge.KeyValue{Key: "a", Value: 1}}, s)
+
+	var kvs []henge.KeyValue
+	assert.NoError(t, henge.New(map[string]int{"a": 1}).Convert(&kvs))
+	assert.Equal(t, []henge.KeyValue{{Key: "a", Value: 1}}, kvs)
+
+	var m map[string]int
+	assert.NoError(t, henge.New(kvs).Convert(&m))
+	assert.Equal(t, map[string]int{"a": 1}, m)
+
+	assert.NoError(t, henge.New([]interface{}{}).Convert(&m))
+	assert.Equal(t, map[string]int{}, m)
+
+	assert.EqualError(
+		t,
+		henge.New([]interface{}{"a", 1, "b"}).Convert(&m),
+		"Failed to convert from string to map[string]int: fields=[2], value=\"b\", error=unsupported type",
+	)
+	assert.EqualError(
+		t,
+		henge.New([]interface{}{[]int{1, 2}, 3}).Convert(&m),
+		"Failed to convert from int to map[string]int: fields=[1], value=3, error=unsupported type",
+	)
+	assert.EqualError(
+		t,
+		henge.New([]interface{}{"a", "x"}).Convert(&m),
+		"Failed to convert from string to int: fields=[0], value=\"x\", error=strconv.ParseInt: parsing \"x\": invalid syntax",
+	)
+}
+
+func TestMapConverter_keyValueSlice_withSliceOptions(t *testing.T) {
+	m := map[string]int{}
+	assert.NoError(t, henge.New([]interface{}{"a", 1, "b", 2}, henge.WithUnwrapSingleton()).Convert(&m))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, m)
+
+	m = map[string]int{}
+	assert.NoError(t, henge.New([][]interface{}{{"a", 1}}, henge.WithUnwrapSingleton()).Convert(&m))
+	assert.Equal(t, map[string]int{"a": 1}, m)
+
+	var kvs []henge.KeyValue
+	assert.NoError(t, henge.New(map[string]int{"a": 1}, henge.WithWrapScalar()).Convert(&kvs))
+	assert.Equal(t, []henge.KeyValue{{Key: "a", Value: 1}}, kvs)
+}
+
+type mapTestUserID string
+
+func TestMapConverter_compositeKeys(t *testing.T) {
+	type Point struct {
+		X, Y int
+	}
+	type StrPoint struct {
+		X, Y string
+	}
+
+	var named map[mapTestUserID]int
+	assert.NoError(t, henge.New(map[string]int{"a": 1}).Convert(&named))
+	assert.Equal(t, map[mapTestUserID]int{"a": 1}, named)
+
+	var structKeys map[StrPoint]int
+	assert.NoError(t, henge.New(map[Point]int{{X: 1, Y: 2}: 3}).Convert(&structKeys))
+	assert.Equal(t, map[StrPoint]int{{X: "1", Y: "2"}: 3}, structKeys)
+
+	var arrayKeys map[[2]string]int
+	assert.NoError(t, henge.New(map[[2]int]int{{1, 2}: 3}).Convert(&arrayKeys))
+	assert.Equal(t, map[[2]string]int{{"1", "2"}: 3}, arrayKeys)
+
+	parse := func(key string) (interface{}, error) {
+		items := strings.Split(key, ",")
+		if len(items) != 2 {
+			return nil, errors.New("invalid point")
+		}
+		return items, nil
+	}
+	format := func(key interface{}) (string, error) {
+		p := key.(Point)
+		return fmt.Sprintf("%d,%d", p.X, p.Y), nil
+	}
+	opts := []henge.ConverterOption{
+		henge.WithMapKeyParser(reflect.TypeOf(Point{}), parse),
+		henge.WithMapKeyFormatter(reflect.TypeOf(Point{}), format),
+	}
+
+	var points map[Point]int
+	assert.NoError(t, henge.New(map[string]interface{}{"1,2": 3}, opts...).Convert(&points))
+	assert.Equal(t, map[Point]int{{X: 1, Y: 2}: 3}, points)
+
+	obj, err := henge.New(points, opts...).JSONObject().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"1,2": int64(3)}, obj)
+
+	var strKeys map[string]int
+	assert.NoError(t, henge.New(points, opts...).Convert(&strKeys))
+	assert.Equal(t, map[string]int{"1,2": 3}, strKeys)
+
+	err = henge.New(map[string]interface{}{"1": 3}, opts...).Convert(&points)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, "[]1", convertError.Field)
+		assert.EqualError(t, convertError.Err, "invalid point")
+	}
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONNumber(t *testing.T) {
+	assert.Equal(t, int64(math.MaxInt64), henge.New(json.Number("9223372036854775807")).Int().Value())
+	assert.Equal(t, int64(1000), henge.New(json.Number("1e3")).Int().Value())
+	assert.Equal(t, int64(-2), henge.New(json.Number("-1.5")).Int().Value())
+	assert.Equal(t, int64(-1), henge.New(json.Number("-1.5"), henge.WithRoundingFunc(math.Ceil)).Int().Value())
+	assert.Equal(t, uint64(math.MaxUint64), henge.New(json.Number("18446744073709551615")).Uint().Value())
+	assert.Equal(t, int64(9007199254740993), henge.New(json.Number("9007199254740993.5")).Int().Value())
+	assert.Equal(t, int64(9007199254740994), henge.New(json.Number("9007199254740993.5"), henge.WithRoundingFunc(math.Ceil)).Int().Value())
+	assert.Equal(t, int64(2), henge.New(json.Number("2.5"), henge.WithRoundingFunc(math.RoundToEven)).Int().Value())
+	assert.Equal(t, int64(-4), henge.New(json.Number("-3.5"), henge.WithRoundingFunc(math.RoundToEven)).Int().Value())
+	assert.Equal(t, int64(3), henge.New(json.Number("2.5"), henge.WithRoundingFunc(math.Round)).Int().Value())
+	assert.Equal(t, int64(-2), henge.New(json.Number("-2.4"), henge.WithRoundingFunc(math.Round)).Int().Value())
+	assert.Equal(t, int64(-3), henge.New(json.Number("-2.6"), henge.WithRoundingFunc(math.Round)).Int().Value())
+	assert.Equal(t, uint64(18446744073709551615), henge.New(json.Number("18446744073709551614.5"), henge.WithRoundingFunc(math.Ceil)).Uint().Value())
+	assert.Equal(t, 0.1, henge.New(json.Number("0.1")).Float().Value())
+
+	var err error
+	_, err = henge.New(json.Number("9223372036854775808")).Int().Result()
+	assert.True(t, errors.Is(err, henge.ErrOverflow))
+	_, err = henge.New(json.Number("-1")).Uint().Result()
+	assert.True(t, errors.Is(err, henge.ErrNegativeNumber))
+	_, err = henge.New(json.Number("1e400")).Float().Result()
+	assert.True(t, errors.Is(err, henge.ErrOverflow))
+	_, err = henge.New(json.Number("a")).Int().Result()
+	assert.True(t, errors.Is(err, henge.ErrInvalidValue))
+
+	assert.Equal(t, int64(1), henge.New(json.Number("1")).JSONValue().Value())
+	assert.Equal(t, uint64(math.MaxUint64), henge.New(json.Number("18446744073709551615")).JSONValue().Value())
+	assert.Equal(t, 1.5, henge.New(json.Number("1.5")).JSONValue().Value())
+}
+
+func TestBigNumber(t *testing.T) {
+	var i big.Int
+	assert.NoError(t, henge.New(json.Number("123456789012345678901234567890")).Convert(&i))
+	assert.Equal(t, "123456789012345678901234567890", i.String())
+	assert.NoError(t, henge.New(json.Number("123456789012345678901234567890.5")).Convert(&i))
+	assert.Equal(t, "123456789012345678901234567890", i.String())
+	assert.NoError(t, henge.New(json.Number("-123456789012345678901234567890.5")).Convert(&i))
+	assert.Equal(t, "-123456789012345678901234567891", i.String())
+
+	var f *big.Float
+	assert.NoError(t, henge.New("1.25").Convert(&f))
+	assert.Equal(t, "1.25", f.Text('f', -1))
+
+	var r big.Rat
+	assert.NoError(t, henge.New(json.Number("0.1")).Convert(&r))
+	assert.Equal(t, "1/10", r.String())
+
+	var out struct {
+		Int   *big.Int
+		Float big.Float
+	}
+	assert.NoError(t, henge.New(map[string]interface{}{"Int": 2, "Float": 0.5}).Convert(&out))
+	assert.Equal(t, "2", out.Int.String())
+	assert.Equal(t, "0.5", out.Float.Text('f', -1))
+
+	err := henge.New(map[string]interface{}{"Int": "a"}).Convert(&out)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Int", convertError.Field)
+		assert.Equal(t, henge.ErrInvalidValue, convertError.Err)
+	}
+
+	assert.Equal(t, int64(-5), henge.New(big.NewInt(-5)).Int().Value())
+	assert.Equal(t, 0.25, henge.New(big.NewRat(1, 4)).Float().Value())
+	assert.Equal(t, int64(3), henge.New(*big.NewFloat(3)).JSONValue().Value())
+}
+
+func TestJSONRawMessage(t *testing.T) {
+	type Payload struct {
+		ID    int64
+		Items []string
+	}
+	type Event struct {
+		Kind    string
+		Payload json.RawMessage
+	}
+	type DecodedEvent struct {
+		Kind    string
+		Payload Payload
+	}
+
+	in := Event{Kind: "a", Payload: json.RawMessage(`{"ID": 9007199254740993, "Items": ["x", "y"]}`)}
+	var out DecodedEvent
+	assert.NoError(t, henge.New(in).Convert(&out))
+	assert.Equal(t, DecodedEvent{Kind: "a", Payload: Payload{ID: 9007199254740993, Items: []string{"x", "y"}}}, out)
+
+	var m map[string]interface{}
+	assert.NoError(t, henge.New(json.RawMessage(`{"a": 1}`)).Convert(&m))
+	assert.Equal(t, map[string]interface{}{"a": json.Number("1")}, m)
+
+	// NOTE: It is treated as a byte slice, when the output is not a struct or a map.
+	var raw json.RawMessage
+	assert.NoError(t, henge.New(in.Payload).Convert(&raw))
+	assert.Equal(t, in.Payload, raw)
+
+	assert.Equal(t, map[string]interface{}{"a": []interface{}{int64(1), 1.5}}, henge.New(json.RawMessage(`{"a": [1, 1.5]}`)).JSONValue().Value())
+
+	err := henge.New(Event{Payload: json.RawMessage(`{`)}).Convert(&out)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Payload", convertError.Field)
+	}
+
+	err = henge.New(Event{Payload: json.RawMessage(`{"ID": 1} garbage`)}).Convert(&out)
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Payload", convertError.Field)
+		assert.Equal(t, henge.ErrTrailingData, convertError.Err)
+	}
+	assert.True(t, errors.Is(henge.New(json.RawMessage(`{"a": 1} {}`)).Convert(&m), henge.ErrTrailingData))
+}
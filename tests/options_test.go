@@ -1,10 +1,13 @@
 package tests
 
 import (
-	"github.com/soranoba/henge/v2"
-	"github.com/stretchr/testify/assert"
+	"errors"
+	"reflect"
 	"testing"
 	"time"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestWithSliceValueConverter_conversionFailed(t *testing.T) {
@@ -63,3 +66,419 @@ func TestWithMapStructValueConverter_withoutMap(t *testing.T) {
 		})).Map().Value(),
 	)
 }
+
+func TestWithSliceSeparator_conversionFailed(t *testing.T) {
+	var out []int
+	assert.EqualError(
+		t,
+		henge.New("1,a", henge.WithSliceSeparator(",")).Convert(&out),
+		"Failed to convert from string to int: fields=[1], value=\"a\", error=strconv.ParseInt: parsing \"a\": invalid syntax",
+	)
+
+	var s string
+	assert.EqualError(
+		t,
+		henge.New([]interface{}{1, struct{}{}}, henge.WithSliceSeparator(",")).Convert(&s),
+		"Failed to convert from struct {} to string: fields=[1], value=struct {}{}, error=unsupported type",
+	)
+}
+
+func TestWithSliceSeparator_emptyString(t *testing.T) {
+	s, err := henge.New("", henge.WithSliceSeparator(",")).StringSlice().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{""}, s)
+
+	s, err = henge.New("", henge.WithSliceSeparator(","), henge.WithoutEmptySliceItem()).StringSlice().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, s)
+
+	_, err = henge.New("a,b").StringSlice().Result()
+	assert.Error(t, err)
+}
+
+func TestWithWrapScalar(t *testing.T) {
+	var out []int
+	assert.NoError(t, henge.New("1", henge.WithWrapScalar()).Convert(&out))
+	assert.Equal(t, []int{1}, out)
+
+	var arr [2]int
+	assert.NoError(t, henge.New(2, henge.WithWrapScalar()).Convert(&arr))
+	assert.Equal(t, [2]int{2, 0}, arr)
+
+	// NOTE: A separator takes precedence over wrapping.
+	assert.NoError(t, henge.New("1,2", henge.WithWrapScalar(), henge.WithSliceSeparator(",")).Convert(&out))
+	assert.Equal(t, []int{1, 2}, out)
+
+	// NOTE: nil is not wrapped.
+	out = nil
+	assert.NoError(t, henge.New((*int)(nil), henge.WithWrapScalar()).Convert(&out))
+	assert.Nil(t, out)
+
+	assert.EqualError(
+		t,
+		henge.New("a", henge.WithWrapScalar()).Convert(&out),
+		"Failed to convert from string to int: fields=[0], value=\"a\", error=strconv.ParseInt: parsing \"a\": invalid syntax",
+	)
+}
+
+func TestWithUnwrapSingleton(t *testing.T) {
+	var s string
+	assert.NoError(t, henge.New([]int{1}, henge.WithUnwrapSingleton()).Convert(&s))
+	assert.Equal(t, "1", s)
+
+	// NOTE: A nil slice keeps the value.
+	assert.NoError(t, henge.New([]int(nil), henge.WithUnwrapSingleton()).Convert(&s))
+	assert.Equal(t, "1", s)
+
+	// NOTE: An empty slice resets the value.
+	assert.NoError(t, henge.New([]string{}, henge.WithUnwrapSingleton()).Convert(&s))
+	assert.Equal(t, "", s)
+
+	// NOTE: A byte slice is converted to string as is.
+	assert.NoError(t, henge.New([]byte("ab"), henge.WithUnwrapSingleton()).Convert(&s))
+	assert.Equal(t, "ab", s)
+
+	var i *int
+	assert.NoError(t, henge.New([1]string{"2"}, henge.WithUnwrapSingleton()).Convert(&i))
+	if assert.NotNil(t, i) {
+		assert.Equal(t, 2, *i)
+	}
+
+	assert.NoError(t, henge.New([0]string{}, henge.WithUnwrapSingleton()).Convert(&i))
+	assert.Nil(t, i)
+
+	err := henge.New([]int{1, 2}, henge.WithUnwrapSingleton()).Convert(&i)
+	assert.True(t, errors.Is(err, henge.ErrMultipleElements))
+}
+
+func TestWithSliceToMapKey(t *testing.T) {
+	type Base struct {
+		ID int `henge:"key"`
+	}
+	type User struct {
+		*Base
+		Name string
+	}
+
+	// NOTE: The key field is found in embedded fields.
+	var m map[int]*User
+	users := []*User{{Base: &Base{ID: 2}, Name: "b"}, {Base: &Base{ID: 1}, Name: "a"}}
+	assert.NoError(t, henge.New(users).Convert(&m))
+	if assert.Len(t, m, 2) {
+		assert.Equal(t, "a", m[1].Name)
+		assert.Equal(t, "b", m[2].Name)
+	}
+
+	var s []User
+	assert.NoError(t, henge.New(m).Convert(&s))
+	if assert.Len(t, s, 2) {
+		assert.Equal(t, "a", s[0].Name)
+		assert.Equal(t, "b", s[1].Name)
+	}
+
+	// NOTE: The embedded field is nil.
+	assert.EqualError(
+		t,
+		henge.New([]User{{Name: "a"}}).Convert(&m),
+		"Failed to convert from tests.User to map[int]*tests.User: fields=[0], value=tests.User{Base:(*tests.Base)(nil), Name:\"a\"}, error=unsupported type",
+	)
+
+	// NOTE: The option takes precedence over the tag.
+	var m2 map[string]User
+	assert.NoError(t, henge.New(users, henge.WithSliceToMapKey("Name")).Convert(&m2))
+	assert.Equal(t, 1, m2["a"].ID)
+	assert.Equal(t, 2, m2["b"].ID)
+
+	assert.EqualError(
+		t,
+		henge.New([]int{1}, henge.WithSliceToMapKey("Name")).Convert(&m2),
+		"Failed to convert from int to map[string]tests.User: fields=[0], value=1, error=unsupported type",
+	)
+}
+
+func TestWithSliceToMapKey_keyValues(t *testing.T) {
+	type In struct {
+		Name string
+		Tags [][]string
+	}
+	type Out struct {
+		Name string
+		Tags map[string]string
+	}
+
+	// NOTE: The option does not apply to the slices whose elements do not have the field.
+	var kvs []henge.KeyValue
+	assert.NoError(t, henge.New(map[string]int{"a": 1}, henge.WithSliceToMapKey("Name")).Convert(&kvs))
+	assert.Equal(t, []henge.KeyValue{{Key: "a", Value: 1}}, kvs)
+
+	var out map[string]Out
+	in := []In{{Name: "a", Tags: [][]string{{"k", "v"}}}}
+	assert.NoError(t, henge.New(in, henge.WithSliceToMapKey("Name")).Convert(&out))
+	assert.Equal(t, map[string]Out{"a": {Name: "a", Tags: map[string]string{"k": "v"}}}, out)
+
+	// NOTE: The keys are not dropped silently.
+	var s []string
+	assert.Equal(
+		t,
+		henge.New(map[string]string{"a": "b"}).Convert(&s),
+		henge.New(map[string]string{"a": "b"}, henge.WithSliceToMapKey("Name")).Convert(&s),
+	)
+}
+
+func TestWithSliceToMapKey_sortedKeys(t *testing.T) {
+	type User struct {
+		Name string
+	}
+
+	var s []User
+	in := map[interface{}]User{10: {Name: "10"}, 9: {Name: "9"}, "1": {Name: "s1"}, 1: {Name: "1"}, "a": {Name: "a"}}
+	assert.NoError(t, henge.New(in, henge.WithSliceToMapKey("Name")).Convert(&s))
+	assert.Equal(t, []User{{Name: "1"}, {Name: "s1"}, {Name: "10"}, {Name: "9"}, {Name: "a"}}, s)
+}
+
+func TestWithSortedMapKeys(t *testing.T) {
+	type Out struct {
+		A int
+	}
+	in := map[interface{}]interface{}{"A": "x", 1: "y", "B": "z"}
+	for i := 0; i < 10; i++ {
+		var out map[string]int
+		err := henge.New(in, henge.WithSortedMapKeys()).Convert(&out)
+		var convertError *henge.ConvertError
+		if assert.True(t, errors.As(err, &convertError)) {
+			assert.Equal(t, "[1]", convertError.Field)
+		}
+
+		_, err = henge.New(map[string]interface{}{"b": Out{A: 1}, "a": "x"}, henge.WithSortedMapKeys(), henge.WithMapValueConverter(func(converter *henge.ValueConverter) henge.Converter {
+			return converter.Int()
+		})).Map().Result()
+		if assert.True(t, errors.As(err, &convertError)) {
+			assert.Equal(t, ".a", convertError.Field)
+		}
+	}
+}
+
+func TestWithMerge(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type User struct {
+		Name    string
+		Age     *int
+		Address Address
+		Meta    map[string]interface{}
+		Tags    []string
+	}
+	type Patch struct {
+		Name    string
+		Age     *int
+		Address Address
+		Meta    map[string]interface{}
+		Tags    []string
+	}
+
+	age := 20
+	newUser := func() User {
+		return User{
+			Name:    "a",
+			Age:     &age,
+			Address: Address{City: "Tokyo", Zip: "100"},
+			Meta:    map[string]interface{}{"x": 1, "nested": map[string]interface{}{"y": 2}},
+			Tags:    []string{"t1"},
+		}
+	}
+
+	// NOTE: struct to struct
+	out := newUser()
+	assert.NoError(t, henge.New(Patch{Address: Address{Zip: "200"}, Tags: []string{"t2"}}, henge.WithMerge()).Convert(&out))
+	assert.Equal(t, "a", out.Name)
+	assert.Equal(t, &age, out.Age)
+	assert.Equal(t, Address{City: "Tokyo", Zip: "200"}, out.Address)
+	assert.Equal(t, []string{"t2"}, out.Tags)
+
+	// NOTE: same struct
+	out = newUser()
+	assert.NoError(t, henge.New(User{Name: "b"}, henge.WithMerge()).Convert(&out))
+	assert.Equal(t, "b", out.Name)
+	assert.Equal(t, Address{City: "Tokyo", Zip: "100"}, out.Address)
+
+	// NOTE: map to struct, and nested maps are merged.
+	out = newUser()
+	patch := map[string]interface{}{
+		"Age":  nil,
+		"Meta": map[string]interface{}{"z": 3, "nested": map[string]interface{}{"w": 4}},
+	}
+	assert.NoError(t, henge.New(patch, henge.WithMerge()).Convert(&out))
+	assert.Equal(t, &age, out.Age)
+	assert.Equal(t, map[string]interface{}{
+		"x":      1,
+		"z":      3,
+		"nested": map[string]interface{}{"y": 2, "w": 4},
+	}, out.Meta)
+
+	// NOTE: map to map
+	m := map[string]map[string]int{"a": {"x": 1}}
+	assert.NoError(t, henge.New(map[string]interface{}{"a": map[string]int{"y": 2}, "b": nil}, henge.WithMerge()).Convert(&m))
+	assert.Equal(t, map[string]map[string]int{"a": {"x": 1, "y": 2}}, m)
+
+	// NOTE: Without the option, the fields are overwritten.
+	out = newUser()
+	assert.NoError(t, henge.New(Patch{Address: Address{Zip: "200"}}).Convert(&out))
+	assert.Equal(t, "", out.Name)
+	assert.Nil(t, out.Age)
+	assert.Equal(t, Address{Zip: "200"}, out.Address)
+}
+
+func TestWithStructFilter(t *testing.T) {
+	type Item struct {
+		ID     int
+		Secret string
+	}
+	type In struct {
+		Name  string
+		Items []Item
+	}
+	type Out struct {
+		Name  string
+		Items []Item
+	}
+
+	var paths []string
+	var out Out
+	in := In{Name: "a", Items: []Item{{ID: 1, Secret: "x"}}}
+	assert.NoError(t, henge.New(in, henge.WithStructFilter(func(path string, v interface{}, field reflect.StructField) bool {
+		paths = append(paths, path)
+		return field.Name != "Secret"
+	})).Convert(&out))
+	assert.Equal(t, Out{Name: "a", Items: []Item{{ID: 1}}}, out)
+	assert.Equal(t, []string{"Name", "Items", "Items.ID", "Items.Secret"}, paths)
+
+	// NOTE: It also applies when converting from map.
+	out = Out{}
+	m := map[string]interface{}{"Name": "a", "Items": []map[string]interface{}{{"ID": 1, "Secret": "x"}}}
+	assert.NoError(t, henge.New(m, henge.WithExcludeFields("Items.Secret")).Convert(&out))
+	assert.Equal(t, Out{Name: "a", Items: []Item{{ID: 1}}}, out)
+
+	out = Out{}
+	assert.NoError(t, henge.New(in, henge.WithIncludeFields("Items.ID")).Convert(&out))
+	assert.Equal(t, Out{Items: []Item{{ID: 1}}}, out)
+
+	out = Out{}
+	assert.NoError(t, henge.New(in, henge.WithIncludeFields("Items"), henge.WithExcludeFields("Items.Secret")).Convert(&out))
+	assert.Equal(t, Out{Items: []Item{{ID: 1}}}, out)
+
+	// NOTE: The same types are also filtered.
+	var same In
+	assert.NoError(t, henge.New(in, henge.WithExcludeFields("Name")).Convert(&same))
+	assert.Equal(t, In{Items: []Item{{ID: 1, Secret: "x"}}}, same)
+}
+
+func TestWithGroups(t *testing.T) {
+	type Profile struct {
+		Bio  string
+		Memo string `henge:"group=admin"`
+	}
+	type User struct {
+		ID      int    `henge:"key,group=admin,group=internal"`
+		Name    string `henge:"group=public,group=admin"`
+		Profile Profile
+	}
+	type Out struct {
+		ID      int
+		Name    string
+		Profile Profile
+	}
+
+	in := User{ID: 1, Name: "a", Profile: Profile{Bio: "b", Memo: "m"}}
+
+	// NOTE: struct to struct (the tags of the input)
+	var out Out
+	assert.NoError(t, henge.New(in, henge.WithGroups("public")).Convert(&out))
+	assert.Equal(t, Out{Name: "a", Profile: Profile{Bio: "b"}}, out)
+
+	out = Out{}
+	assert.NoError(t, henge.New(in, henge.WithGroups("internal")).Convert(&out))
+	assert.Equal(t, Out{ID: 1, Profile: Profile{Bio: "b"}}, out)
+
+	out = Out{}
+	assert.NoError(t, henge.New(in).Convert(&out))
+	assert.Equal(t, Out{ID: 1, Name: "a", Profile: Profile{Bio: "b", Memo: "m"}}, out)
+
+	// NOTE: struct to map
+	m, err := henge.New(in, henge.WithGroups("public")).Map().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, map[interface{}]interface{}{
+		"Name":    "a",
+		"Profile": map[interface{}]interface{}{"Bio": "b"},
+	}, m)
+
+	// NOTE: map to struct (the tags of the output)
+	var user User
+	assert.NoError(t, henge.New(map[string]interface{}{"ID": 1, "Name": "a"}, henge.WithGroups("public")).Convert(&user))
+	assert.Equal(t, User{Name: "a"}, user)
+
+	// NOTE: The key field is still available.
+	var users map[int]User
+	assert.NoError(t, henge.New([]User{in}, henge.WithGroups("admin")).Convert(&users))
+	assert.Equal(t, map[int]User{1: in}, users)
+
+	// NOTE: The groups are specified with the prefix, so they are not confused with the other options.
+	type Item struct {
+		A string `henge:"group=omitempty"`
+		B string `henge:"group=public,omitempty"`
+	}
+	var item Item
+	assert.NoError(t, henge.New(map[string]interface{}{"A": "a", "B": ""}, henge.WithGroups("public")).Convert(&item))
+	assert.Equal(t, Item{B: ""}, item)
+	item = Item{B: "b"}
+	assert.NoError(t, henge.New(map[string]interface{}{"A": "", "B": ""}).Convert(&item))
+	assert.Equal(t, Item{B: "b"}, item)
+
+	assert.Panics(t, func() { henge.WithGroups("key") })
+	assert.Panics(t, func() { henge.WithGroups("") })
+}
+
+func TestWithArrayLengthPolicy(t *testing.T) {
+	cases := []struct {
+		policy  henge.ArrayLengthPolicy
+		short   error
+		long    error
+		shorted [3]int
+		longed  [3]int
+	}{
+		{policy: henge.ArrayLengthTruncateOrPad, shorted: [3]int{1, 2, 0}, longed: [3]int{1, 2, 3}},
+		{policy: henge.ArrayLengthStrict, short: henge.ErrLengthMismatch, long: henge.ErrLengthMismatch},
+		{policy: henge.ArrayLengthTruncate, short: henge.ErrLengthMismatch, longed: [3]int{1, 2, 3}},
+		{policy: henge.ArrayLengthPad, shorted: [3]int{1, 2, 0}, long: henge.ErrLengthMismatch},
+	}
+	for _, c := range cases {
+		var out [3]int
+		err := henge.New([]string{"1", "2"}, henge.WithArrayLengthPolicy(c.policy)).Convert(&out)
+		assert.True(t, errors.Is(err, c.short), "policy=%v err=%v", c.policy, err)
+		if err == nil {
+			assert.Equal(t, c.shorted, out)
+		}
+
+		out = [3]int{}
+		err = henge.New([]string{"1", "2", "3", "4"}, henge.WithArrayLengthPolicy(c.policy)).Convert(&out)
+		assert.True(t, errors.Is(err, c.long), "policy=%v err=%v", c.policy, err)
+		if err == nil {
+			assert.Equal(t, c.longed, out)
+		}
+
+		var ok [3]int
+		assert.NoError(t, henge.New([]int{1, 2, 3}, henge.WithArrayLengthPolicy(c.policy)).Convert(&ok))
+	}
+
+	// NOTE: It also applies to byte arrays, and the field is reported.
+	var out struct {
+		ID [4]byte
+	}
+	err := henge.New(map[string]interface{}{"ID": "abc"}, henge.WithArrayLengthPolicy(henge.ArrayLengthStrict)).Convert(&out)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".ID", convertError.Field)
+		assert.Equal(t, henge.ErrLengthMismatch, convertError.Err)
+	}
+}
@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type schemaTestNode struct {
+	Value    int8
+	Children []schemaTestNode
+	Parent   *schemaTestNode
+}
+
+type schemaTestItem struct {
+	ID     int64
+	Secret string `henge:"-"`
+	Admin  string `henge:"group=admin"`
+}
+
+func TestJSONSchema(t *testing.T) {
+	type Root struct {
+		Node   schemaTestNode
+		Items  map[string]schemaTestItem
+		Shapes []shape
+		Any    interface{}
+		Array  [2]float64
+		Point  struct {
+			X float32 `henge:"index=0"`
+			Y float32 `henge:"index=2"`
+		}
+		private int
+	}
+
+	number := []string{"number", "string", "boolean"}
+	opts := []henge.ConverterOption{
+		henge.WithImplementations(reflect.TypeOf((*shape)(nil)).Elem(), "type", shapeTypes),
+		henge.WithArrayLengthPolicy(henge.ArrayLengthStrict),
+		henge.WithExcludeFields("Items.ID"),
+	}
+	assert.Equal(t, map[string]interface{}{
+		"$schema": henge.JSONSchemaDialect,
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"Node": map[string]interface{}{"$ref": "#/$defs/tests.schemaTestNode"},
+			"Items": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": map[string]interface{}{"$ref": "#/$defs/tests.schemaTestItem"},
+			},
+			"Shapes": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"oneOf": []interface{}{
+						map[string]interface{}{
+							"allOf": []interface{}{
+								map[string]interface{}{"$ref": "#/$defs/tests.circle"},
+								map[string]interface{}{
+									"type":       "object",
+									"properties": map[string]interface{}{"type": map[string]interface{}{"const": "circle"}},
+									"required":   []string{"type"},
+								},
+							},
+						},
+						map[string]interface{}{
+							"allOf": []interface{}{
+								map[string]interface{}{"$ref": "#/$defs/tests.rect"},
+								map[string]interface{}{
+									"type":       "object",
+									"properties": map[string]interface{}{"type": map[string]interface{}{"const": "rect"}},
+									"required":   []string{"type"},
+								},
+							},
+						},
+					},
+				},
+			},
+			"Any": map[string]interface{}{},
+			"Array": map[string]interface{}{
+				"type":     "array",
+				"items":    map[string]interface{}{"type": number},
+				"minItems": 2,
+				"maxItems": 2,
+			},
+			"Point": map[string]interface{}{
+				"anyOf": []interface{}{
+					map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"X": map[string]interface{}{"type": number},
+							"Y": map[string]interface{}{"type": number},
+						},
+					},
+					map[string]interface{}{
+						"type": "array",
+						"prefixItems": []interface{}{
+							map[string]interface{}{"type": number},
+							map[string]interface{}{},
+							map[string]interface{}{"type": number},
+						},
+					},
+				},
+			},
+		},
+		"$defs": map[string]interface{}{
+			"tests.schemaTestNode": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"Value": map[string]interface{}{"type": number, "minimum": int64(-128), "maximum": int64(127)},
+					"Children": map[string]interface{}{
+						"type":  "array",
+						"items": map[string]interface{}{"$ref": "#/$defs/tests.schemaTestNode"},
+					},
+					"Parent": map[string]interface{}{"$ref": "#/$defs/tests.schemaTestNode"},
+				},
+			},
+			"tests.schemaTestItem": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"Admin": map[string]interface{}{"type": []string{"string", "number", "boolean"}},
+				},
+			},
+			"tests.circle": map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"Radius": map[string]interface{}{"type": number}},
+			},
+			"tests.rect": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"Width":  map[string]interface{}{"type": number},
+					"Height": map[string]interface{}{"type": number},
+				},
+			},
+		},
+	}, henge.JSONSchema(reflect.TypeOf(Root{}), opts...))
+
+	// NOTE: The reference to the root type.
+	schema := henge.JSONSchema(reflect.TypeOf(&schemaTestNode{}))
+	assert.Equal(t, map[string]interface{}{"$ref": "#"}, schema["properties"].(map[string]interface{})["Parent"])
+	assert.Nil(t, schema["$defs"])
+
+	schema = henge.JSONSchema(reflect.TypeOf(schemaTestItem{}), henge.WithGroups("admin"))
+	assert.Equal(t, map[string]interface{}{
+		"ID":    map[string]interface{}{"type": number, "minimum": int64(-9223372036854775808), "maximum": int64(9223372036854775807)},
+		"Admin": map[string]interface{}{"type": []string{"string", "number", "boolean"}},
+	}, schema["properties"])
+
+	schema = henge.JSONSchema(reflect.TypeOf([]string{}), henge.WithSliceSeparator(","), henge.WithWrapScalar())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": []string{"string", "number", "boolean", "array"}}},
+		map[string]interface{}{"type": "string"},
+		map[string]interface{}{"type": []string{"string", "number", "boolean", "array"}},
+	}, schema["anyOf"])
+}
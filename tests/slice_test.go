@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/soranoba/henge/v2"
@@ -13,6 +14,14 @@ func TestSliceConverter_interface(t *testing.T) {
 	var _ henge.Converter = henge.New(nil).UintSlice()
 	var _ henge.Converter = henge.New(nil).FloatSlice()
 	var _ henge.Converter = henge.New(nil).StringSlice()
+	var _ henge.Converter = henge.New(nil).BoolSlice()
+	var _ henge.Converter = henge.New(nil).IntPtrSlice()
+	var _ henge.Converter = henge.New(nil).UintPtrSlice()
+	var _ henge.Converter = henge.New(nil).FloatPtrSlice()
+	var _ henge.Converter = henge.New(nil).StringPtrSlice()
+	var _ henge.Converter = henge.New(nil).BoolPtrSlice()
+	var _ henge.Converter = henge.New(nil).MapSlice()
+	var _ henge.Converter = henge.New(nil).JSONValueSlice()
 }
 
 func TestSliceConverter_nil(t *testing.T) {
@@ -56,3 +65,130 @@ func TestSliceConverter_Convert_nilValue(t *testing.T) {
 		assert.Nil(t, a[1])
 	}
 }
+
+func TestSliceConverter_PtrSlice(t *testing.T) {
+	s, err := henge.New([]interface{}{"1", (*uint)(nil), (*int)(nil), 2.5}).IntPtrSlice().Result()
+	assert.NoError(t, err)
+	if assert.Equal(t, 4, len(s)) {
+		assert.Equal(t, int64(1), *s[0])
+		assert.Nil(t, s[1])
+		assert.Nil(t, s[2])
+		assert.Equal(t, int64(2), *s[3])
+	}
+
+	_, err = henge.New([]interface{}{1, -1}).UintPtrSlice().Result()
+	assert.EqualError(t, err, "Failed to convert from int to *uint64: fields=[1], value=-1, error=negative number")
+}
+
+func TestSliceConverter_MapSlice(t *testing.T) {
+	type In struct {
+		A int
+	}
+	s, err := henge.New([]interface{}{In{A: 1}, map[interface{}]interface{}{"B": 2}}).MapSlice().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"A": 1}, {"B": 2}}, s)
+
+	_, err = henge.New([]interface{}{In{A: 1}, 1}).MapSlice().Result()
+	assert.EqualError(t, err, "Failed to convert from int to map[string]interface {}: fields=[1], value=1, error=unsupported type")
+}
+
+func TestSliceConverter_JSONValueSlice(t *testing.T) {
+	type In struct {
+		A int8
+	}
+	s, err := henge.New([]interface{}{In{A: 1}, uint8(2), "a", []In{{A: 3}}}).JSONValueSlice().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"A": int64(1)},
+		uint64(2),
+		"a",
+		[]interface{}{map[string]interface{}{"A": int64(3)}},
+	}, s)
+
+	s, err = henge.New(([]In)(nil)).JSONValueSlice().Result()
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+
+	_, err = henge.New(1).JSONValueSlice().Result()
+	assert.EqualError(t, err, "Failed to convert from int to []interface {}: fields=, value=1, error=unsupported type")
+}
+
+func TestToSlice(t *testing.T) {
+	assert.Equal(t, []string{"1", "2"}, henge.ToStringSlice([]int{1, 2}))
+	assert.Equal(t, []int64{1, 2}, henge.ToIntSlice([]string{"1", "2"}))
+	assert.Equal(t, []uint64{1, 2}, henge.ToUintSlice([]string{"1", "2"}))
+	assert.Equal(t, []float64{1.5, 2}, henge.ToFloatSlice([]string{"1.5", "2"}))
+	assert.Equal(t, []bool{true, false}, henge.ToBoolSlice([]int{1, 0}))
+	assert.Equal(t, []*string{henge.ToStringPtr("1")}, henge.ToStringPtrSlice([]int{1}))
+	assert.Equal(t, []*int64{henge.ToIntPtr(1)}, henge.ToIntPtrSlice([]string{"1"}))
+	assert.Equal(t, []*uint64{henge.ToUintPtr(1)}, henge.ToUintPtrSlice([]string{"1"}))
+	assert.Equal(t, []*float64{henge.ToFloatPtr(1.5)}, henge.ToFloatPtrSlice([]string{"1.5"}))
+	assert.Equal(t, []*bool{henge.ToBoolPtr(true)}, henge.ToBoolPtrSlice([]int{1}))
+	assert.Equal(t, []map[string]interface{}{{"a": 1}}, henge.ToMapSlice([]map[string]int{{"a": 1}}))
+	assert.Equal(t, []interface{}{int64(1)}, henge.ToJSONValueSlice([]int{1}))
+	assert.Nil(t, henge.ToIntSlice([]string{"a"}))
+}
+
+func TestSliceConverter_Tuple(t *testing.T) {
+	type Embedded struct {
+		Note string
+	}
+	type Row struct {
+		Embedded
+		ID   int
+		name string
+		Age  *int
+	}
+	type IndexedRow struct {
+		Name string `henge:"index=2"`
+		ID   uint   `henge:"index=0"`
+		Memo string
+	}
+
+	var row Row
+	assert.NoError(t, henge.New([]interface{}{map[string]interface{}{"Note": "n"}, "1", "20", "extra"}).Convert(&row))
+	age := 20
+	assert.Equal(t, Row{Embedded: Embedded{Note: "n"}, ID: 1, Age: &age}, row)
+
+	var s []interface{}
+	assert.NoError(t, henge.New(row).Convert(&s))
+	assert.Equal(t, []interface{}{Embedded{Note: "n"}, 1, &age}, s)
+
+	var indexed IndexedRow
+	assert.NoError(t, henge.New([]string{"1", "x", "a"}).Convert(&indexed))
+	assert.Equal(t, IndexedRow{ID: 1, Name: "a"}, indexed)
+
+	// NOTE: The positions without fields are nil.
+	assert.NoError(t, henge.New(indexed).Convert(&s))
+	assert.Equal(t, []interface{}{uint(1), nil, "a"}, s)
+
+	err := henge.New([]interface{}{"a"}).Convert(&indexed)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, "[0]", convertError.Field)
+	}
+}
+
+func TestSliceConverter_TupleIndex(t *testing.T) {
+	type Sparse struct {
+		A string `henge:"index=0"`
+		B string `henge:"index=1000000000"`
+	}
+
+	// NOTE: Only the positions in the input are used, so the large index does not allocate the memory.
+	var sparse Sparse
+	assert.NoError(t, henge.New([]string{"a", "b"}).Convert(&sparse))
+	assert.Equal(t, Sparse{A: "a"}, sparse)
+
+	type Duplicate struct {
+		A string `henge:"index=0"`
+		B string `henge:"index=0"`
+	}
+
+	var duplicate Duplicate
+	err := henge.New([]string{"a"}).Convert(&duplicate)
+	assert.True(t, errors.Is(err, henge.ErrInvalidTag))
+
+	_, err = henge.New(Duplicate{A: "a", B: "b"}).Slice().Result()
+	assert.True(t, errors.Is(err, henge.ErrInvalidTag))
+}
@@ -508,3 +508,194 @@ func TestStructConverter_Error(t *testing.T) {
 		assert.Equal(t, reflect.TypeOf((int)(1)), convertError.DstType)
 	}
 }
+
+func TestStructConverter_OmitEmptyField(t *testing.T) {
+	type In struct {
+		Name string
+		Age  *int
+	}
+	type Out struct {
+		Name string `henge:"omitempty"`
+		Age  *int   `henge:"omitempty"`
+	}
+
+	age := 20
+	out := Out{Name: "a", Age: &age}
+	assert.NoError(t, henge.New(In{}).Convert(&out))
+	assert.Equal(t, Out{Name: "a", Age: &age}, out)
+
+	assert.NoError(t, henge.New(map[string]interface{}{"Name": "", "Age": nil}).Convert(&out))
+	assert.Equal(t, Out{Name: "a", Age: &age}, out)
+
+	assert.NoError(t, henge.New(In{Name: "b"}).Convert(&out))
+	assert.Equal(t, Out{Name: "b", Age: &age}, out)
+}
+
+type getterIn struct {
+	FirstName string
+	LastName  string
+	age       int
+}
+
+func (in getterIn) FullName() string {
+	return in.FirstName + " " + in.LastName
+}
+
+func (in *getterIn) GetAge() int {
+	return in.age
+}
+
+func (in *getterIn) Email() (string, error) {
+	if in.FirstName == "" {
+		return "", errors.New("no name")
+	}
+	return in.FirstName + "@example.com", nil
+}
+
+func TestStructConverter_GetterMethods(t *testing.T) {
+	type Out struct {
+		FirstName string
+		FullName  string
+		Age       int
+		Email     string
+	}
+
+	var out Out
+	in := getterIn{FirstName: "a", LastName: "b", age: 20}
+	assert.NoError(t, henge.New(in, henge.WithGetterMethods()).Convert(&out))
+	assert.Equal(t, Out{FirstName: "a", FullName: "a b", Age: 20, Email: "a@example.com"}, out)
+
+	out = Out{}
+	assert.NoError(t, henge.New(&in).Convert(&out))
+	assert.Equal(t, Out{FirstName: "a"}, out)
+
+	err := henge.New(&getterIn{}, henge.WithGetterMethods()).Convert(&out)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Email", convertError.Field)
+		assert.EqualError(t, convertError.Err, "no name")
+	}
+}
+
+type setterOut struct {
+	ID   int
+	name string
+	tags []string
+}
+
+func (out *setterOut) SetName(name string) {
+	out.name = name
+}
+
+func (out *setterOut) SetTags(tags []string) error {
+	if len(tags) == 0 {
+		return errors.New("empty tags")
+	}
+	out.tags = tags
+	return nil
+}
+
+func TestStructConverter_SetterMethods(t *testing.T) {
+	type In struct {
+		ID   int
+		Name string
+		Tags string
+	}
+
+	var out setterOut
+	assert.NoError(t, henge.New(In{ID: 1, Name: "a", Tags: "x,y"}, henge.WithSetterMethods(), henge.WithSliceSeparator(",")).Convert(&out))
+	assert.Equal(t, setterOut{ID: 1, name: "a", tags: []string{"x", "y"}}, out)
+
+	out = setterOut{}
+	assert.NoError(t, henge.New(In{ID: 1, Name: "a"}).Convert(&out))
+	assert.Equal(t, setterOut{ID: 1}, out)
+
+	// NOTE: It also applies when converting from map.
+	out = setterOut{}
+	assert.NoError(t, henge.New(map[string]interface{}{"Name": 1, "Tags": []int{2}}, henge.WithSetterMethods()).Convert(&out))
+	assert.Equal(t, setterOut{name: "1", tags: []string{"2"}}, out)
+
+	err := henge.New(map[string]interface{}{"Tags": []string{}}, henge.WithSetterMethods()).Convert(&out)
+	var convertError *henge.ConvertError
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Tags", convertError.Field)
+		assert.EqualError(t, convertError.Err, "empty tags")
+	}
+}
+
+func TestStructConverter_UnexportedFields(t *testing.T) {
+	type In struct {
+		Name string
+		id   int
+	}
+	type Out struct {
+		Name string
+		id   string
+	}
+
+	// NOTE: By default, private fields are copied only for the same struct.
+	var out Out
+	assert.NoError(t, henge.New(In{Name: "a", id: 1}).Convert(&out))
+	assert.Equal(t, Out{Name: "a"}, out)
+
+	var same In
+	assert.NoError(t, henge.New(In{Name: "a", id: 1}).Convert(&same))
+	assert.Equal(t, In{Name: "a", id: 1}, same)
+
+	out = Out{}
+	assert.NoError(t, henge.New(In{Name: "a", id: 1}, henge.WithUnexportedFields()).Convert(&out))
+	assert.Equal(t, Out{Name: "a", id: "1"}, out)
+
+	same = In{}
+	assert.NoError(t, henge.New(&In{Name: "a", id: 1}, henge.WithoutUnexportedFields()).Convert(&same))
+	assert.Equal(t, In{Name: "a"}, same)
+
+	// NOTE: It also applies when converting from map.
+	out = Out{}
+	assert.NoError(t, henge.New(map[string]interface{}{"Name": "a", "id": 1}).Convert(&out))
+	assert.Equal(t, Out{Name: "a"}, out)
+
+	assert.NoError(t, henge.New(map[string]interface{}{"id": 2}, henge.WithUnexportedFields()).Convert(&out))
+	assert.Equal(t, Out{Name: "a", id: "2"}, out)
+}
+
+type promotedInner struct {
+	A int
+}
+
+type promotedOut struct {
+	promotedInner
+	B int
+}
+
+type promotedPtrOut struct {
+	*promotedInner
+	B int
+}
+
+func TestStructConverter_PromotedFieldsOfUnexportedEmbeddedStruct(t *testing.T) {
+	var out promotedOut
+	assert.NoError(t, henge.New(map[string]interface{}{"A": 1, "B": 2}).Convert(&out))
+	assert.Equal(t, promotedOut{promotedInner: promotedInner{A: 1}, B: 2}, out)
+
+	out = promotedOut{}
+	assert.NoError(t, henge.New(struct{ A, B int }{A: 1, B: 2}).Convert(&out))
+	assert.Equal(t, promotedOut{promotedInner: promotedInner{A: 1}, B: 2}, out)
+
+	out = promotedOut{}
+	assert.NoError(t, henge.New(map[string]interface{}{"A": 1, "B": 2}, henge.WithoutUnexportedFields()).Convert(&out))
+	assert.Equal(t, promotedOut{promotedInner: promotedInner{A: 1}, B: 2}, out)
+
+	// NOTE: The nil pointer of the unexported embedded struct cannot be initialized without WithUnexportedFields.
+	var ptrOut promotedPtrOut
+	assert.NoError(t, henge.New(map[string]interface{}{"A": 1, "B": 2}).Convert(&ptrOut))
+	assert.Equal(t, promotedPtrOut{B: 2}, ptrOut)
+
+	ptrOut = promotedPtrOut{promotedInner: &promotedInner{}}
+	assert.NoError(t, henge.New(struct{ A, B int }{A: 1, B: 2}).Convert(&ptrOut))
+	assert.Equal(t, promotedPtrOut{promotedInner: &promotedInner{A: 1}, B: 2}, ptrOut)
+
+	ptrOut = promotedPtrOut{}
+	assert.NoError(t, henge.New(map[string]interface{}{"A": 1, "B": 2}, henge.WithUnexportedFields()).Convert(&ptrOut))
+	assert.Equal(t, promotedPtrOut{promotedInner: &promotedInner{A: 1}, B: 2}, ptrOut)
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/soranoba/henge/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithYAML(t *testing.T) {
+	type Server struct {
+		Host    string `yaml:"host"`
+		Port    int    `yaml:"port,omitempty"`
+		Secret  string `yaml:"-"`
+		Enabled bool
+	}
+	type Config struct {
+		Servers []Server               `yaml:"servers"`
+		Codes   map[string]string      `yaml:"codes"`
+		Extra   interface{}            `yaml:"extra"`
+		Labels  map[string]interface{} `yaml:"labels"`
+	}
+
+	in := map[interface{}]interface{}{
+		"servers": []interface{}{
+			map[interface{}]interface{}{"host": "a", "port": "80", "Secret": "x", "Enabled": true},
+		},
+		"codes": map[interface{}]interface{}{200: "OK", true: "yes"},
+		"extra": map[interface{}]interface{}{1: []interface{}{map[interface{}]interface{}{false: 1.5}}},
+		"labels": map[interface{}]interface{}{
+			"a": map[interface{}]interface{}{2: nil},
+		},
+	}
+
+	var out Config
+	assert.NoError(t, henge.New(in, henge.WithYAML()).Convert(&out))
+	assert.Equal(t, Config{
+		Servers: []Server{{Host: "a", Port: 80, Enabled: true}},
+		Codes:   map[string]string{"200": "OK", "true": "yes"},
+		Extra:   map[string]interface{}{"1": []interface{}{map[string]interface{}{"false": 1.5}}},
+		Labels:  map[string]interface{}{"a": map[string]interface{}{"2": nil}},
+	}, out)
+
+	// NOTE: The tag names are also used when converting struct to map.
+	m, err := henge.New(out.Servers[0], henge.WithYAML()).JSONObject().Result()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"host": "a", "port": int64(80), "Enabled": true}, m)
+
+	// NOTE: Without the option, the keys are not normalized and the tags are not used.
+	out = Config{}
+	assert.NoError(t, henge.New(map[interface{}]interface{}{"extra": in["extra"], "Extra": in["extra"]}).Convert(&out))
+	assert.Equal(t, in["extra"], out.Extra)
+}
+
+func TestWithYAML_duplicateKey(t *testing.T) {
+	type Config struct {
+		Name  string
+		Codes map[string]int
+	}
+
+	var convertError *henge.ConvertError
+	err := henge.New(map[interface{}]interface{}{"Codes": map[interface{}]interface{}{1: 1, "1": 2}}, henge.WithYAML(), henge.WithSortedMapKeys()).Convert(&Config{})
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, ".Codes[]1", convertError.Field)
+		assert.Equal(t, henge.ErrDuplicateKey, convertError.Err)
+	}
+
+	err = henge.New(map[interface{}]interface{}{true: 1, "true": 2}, henge.WithYAML()).Convert(&Config{})
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, "[]true", convertError.Field)
+		assert.Equal(t, henge.ErrDuplicateKey, convertError.Err)
+	}
+
+	var out interface{}
+	err = henge.New(map[interface{}]interface{}{"a": map[interface{}]interface{}{1: 1, "1": 2}}, henge.WithYAML()).Convert(&out)
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, "[a][]1", convertError.Field)
+		assert.Equal(t, henge.ErrDuplicateKey, convertError.Err)
+	}
+
+	_, err = henge.New(map[interface{}]interface{}{1: 1, "1": 2}, henge.WithYAML()).JSONObject().Result()
+	if assert.True(t, errors.As(err, &convertError)) {
+		assert.Equal(t, henge.ErrDuplicateKey, convertError.Err)
+	}
+
+	// NOTE: The last one wins without the option.
+	var m map[string]int
+	assert.NoError(t, henge.New(map[interface{}]interface{}{1: 1, "1": 1}).Convert(&m))
+	assert.Equal(t, map[string]int{"1": 1}, m)
+}
@@ -27,6 +27,9 @@ type (
 // --------------------------------------------------------------------- //
 
 // Uint converts the input to uint type.
+//
+// json.Number and the types of math/big package (big.Int, big.Float and big.Rat) are converted exactly,
+// and the fractional part is rounded by the function specified by WithRoundingFunc.
 func (c *ValueConverter) Uint() *UnsignedIntegerConverter {
 	var (
 		value uint64
@@ -64,7 +67,17 @@ func (c *ValueConverter) Uint() *UnsignedIntegerConverter {
 				value = 1
 			}
 		case reflect.String:
-			value, err = strconv.ParseUint(inV.Interface().(string), 10, 64)
+			if inT == jsonNumberType {
+				value, err = c.preciseUint()
+			} else {
+				value, err = strconv.ParseUint(inV.String(), 10, 64)
+			}
+		case reflect.Struct:
+			if isBigNumberType(inT) {
+				value, err = c.preciseUint()
+			} else {
+				err = ErrUnsupportedType
+			}
 		default:
 			err = ErrUnsupportedType
 		}
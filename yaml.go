@@ -0,0 +1,51 @@
+package henge
+
+import (
+	"reflect"
+	"strings"
+)
+
+// yamlFieldName returns the key of the field used in the map, that is the name of `yaml:"name"` tag if WithYAML is specified.
+// It returns false if the field is tagged with `yaml:"-"`.
+func (c *baseConverter) yamlFieldName(t reflect.Type, field structField) (string, bool) {
+	if !c.opts.mapOpts.yaml {
+		return field.name, true
+	}
+	name := strings.Split(t.FieldByIndex(field.index).Tag.Get("yaml"), ",")[0]
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return field.name, true
+	default:
+		return name, true
+	}
+}
+
+// convertYAMLInterface converts the map or the slice to map[string]interface{} or []interface{} and assigns it.
+// It returns false if the input is not a map or a slice.
+func (c *ValueConverter) convertYAMLInterface(outV reflect.Value) (bool, error) {
+	if c.isNil {
+		return false, nil
+	}
+
+	var v reflect.Value
+	inV := reflect.Indirect(c.reflectValue)
+	switch inV.Kind() {
+	case reflect.Map:
+		v = reflect.New(reflect.TypeOf(map[string]interface{}{})).Elem()
+	case reflect.Array, reflect.Slice:
+		if isBytesType(inV.Type()) {
+			return false, nil
+		}
+		v = reflect.New(reflect.TypeOf([]interface{}{})).Elem()
+	default:
+		return false, nil
+	}
+
+	if err := c.convert(v); err != nil {
+		return true, err
+	}
+	outV.Set(v)
+	return true, nil
+}